@@ -3,7 +3,7 @@ module github.com/tnyim/jungletv
 go 1.16
 
 require (
-	github.com/DisgoOrg/disgohook v1.4.0 // indirect
+	github.com/DisgoOrg/disgohook v1.4.0
 	github.com/bwmarrin/snowflake v0.3.0
 	github.com/desertbit/timer v0.0.0-20180107155436-c41aec40b27f // indirect
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
@@ -19,7 +19,7 @@ require (
 	github.com/improbable-eng/grpc-web v0.14.0
 	github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f // indirect
 	github.com/palantir/stacktrace v0.0.0-20161112013806-78658fd2d177
-	github.com/patrickmn/go-cache v2.1.0+incompatible // indirect
+	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/rickb777/date v1.15.3
 	github.com/rs/cors v1.7.0 // indirect
 	github.com/satori/go.uuid v1.2.0
@@ -29,7 +29,7 @@ require (
 	google.golang.org/grpc v1.37.1
 	google.golang.org/protobuf v1.26.0
 	gopkg.in/alexcesaro/statsd.v2 v2.0.0
-	nhooyr.io/websocket v1.8.7 // indirect
+	nhooyr.io/websocket v1.8.7
 )
 
 replace github.com/hectorchu/gonano v0.1.15 => github.com/gbl08ma/gonano v0.1.16-0.20210701223933-4588b0df0a78
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"html/template"
 	"log"
+	"math/big"
 	"net/http"
 	"os"
 	"strconv"
@@ -91,21 +92,11 @@ func main() {
 		mainLog.Fatalln("Key file path not present in keybox")
 	}
 
-	queueFile, present := secrets.Get("queueFile")
-	if !present {
-		mainLog.Println("Queue file path not present in keybox, will not persist queue")
-	}
-
 	bansFile, present := secrets.Get("bansFile")
 	if !present {
 		mainLog.Println("Bans file path not present in keybox, will not persist moderation decisions")
 	}
 
-	autoEnqueueVideoListFile, present := secrets.Get("autoEnqueueVideosFile")
-	if !present {
-		mainLog.Println("Auto enqueue videos file path not present in keybox, will not auto enqueue videos")
-	}
-
 	websiteURL, present = secrets.Get("websiteURL")
 	if !present {
 		mainLog.Fatalln("Website URL not present in keybox")
@@ -184,10 +175,144 @@ func main() {
 		mainLog.Println("ModLog webhook not present in keybox, will not send moderation log to Discord")
 	}
 
+	directMediaHosts := []string{}
+	directMediaHostsStr, present := secrets.Get("directMediaHosts")
+	if present {
+		directMediaHosts = strings.Split(directMediaHostsStr, ",")
+	} else {
+		mainLog.Println("Direct media hosts not present in keybox, will not allow enqueuing of direct media")
+	}
+
+	requesterQuota := server.RequesterQuota{}
+	maxEntriesPerRequester, present := secrets.Get("queueMaxEntriesPerRequester")
+	if present {
+		requesterQuota.MaxEntries, err = strconv.Atoi(maxEntriesPerRequester)
+		if err != nil {
+			mainLog.Fatalln("invalid queueMaxEntriesPerRequester:", err)
+		}
+	}
+	maxLengthPerRequester, present := secrets.Get("queueMaxLengthPerRequester")
+	if present {
+		requesterQuota.MaxTotalLength, err = time.ParseDuration(maxLengthPerRequester)
+		if err != nil {
+			mainLog.Fatalln("invalid queueMaxLengthPerRequester:", err)
+		}
+	}
+
+	fairQueueInterleavingStr, present := secrets.Get("queueFairInterleaving")
+	fairQueueInterleaving := present && fairQueueInterleavingStr == "true"
+
+	mediaRepeatWindow := time.Duration(0)
+	mediaRepeatWindowHours, present := secrets.Get("mediaRepeatWindowHours")
+	if present {
+		hours, err := strconv.Atoi(mediaRepeatWindowHours)
+		if err != nil {
+			mainLog.Fatalln("invalid mediaRepeatWindowHours:", err)
+		}
+		mediaRepeatWindow = time.Duration(hours) * time.Hour
+	}
+
+	paymentAccountPoolFile, present := secrets.Get("paymentAccountPoolFile")
+	if !present {
+		mainLog.Println("Payment account pool file path not present in keybox, will not persist payment account pool")
+	}
+
+	refundPolicy := server.RefundPolicy{}
+	refundExcessPaymentsStr, present := secrets.Get("refundExcessPayments")
+	refundPolicy.RefundExcess = present && refundExcessPaymentsStr == "true"
+	refundExcessMinimum, present := secrets.Get("refundExcessMinimum")
+	if present {
+		minimum, ok := new(big.Int).SetString(refundExcessMinimum, 10)
+		if !ok {
+			mainLog.Fatalln("invalid refundExcessMinimum")
+		}
+		refundPolicy.MinimumExcess = server.Amount{Int: minimum}
+	}
+
+	refundLedgerFile, present := secrets.Get("refundLedgerFile")
+	if !present {
+		mainLog.Println("Refund ledger file path not present in keybox, refunds will only be logged")
+	}
+
+	pricingFile, present := secrets.Get("pricingFile")
+	if !present {
+		mainLog.Println("Pricing file path not present in keybox, will use default pricing and not persist pricing changes")
+	}
+
+	nodeWebsocketURL, present := secrets.Get("nodeWebsocketURL")
+	if !present {
+		mainLog.Println("Node websocket URL not present in keybox, will detect payments and sign-ins by polling only")
+	}
+
+	prepaidBalancesFile, present := secrets.Get("prepaidBalancesFile")
+	if !present {
+		mainLog.Println("Prepaid balances file path not present in keybox, prepaid balances will be disabled")
+	}
+
+	revenueSplit := server.RevenueSplit{}
+	revenueSplit.TreasuryAddress, _ = secrets.Get("revenueTreasuryAddress")
+	revenueSplit.DonationAddress, _ = secrets.Get("revenueDonationAddress")
+	for key, basisPoints := range map[string]*int64{
+		"revenueTreasuryBasisPoints":          &revenueSplit.TreasuryBasisPoints,
+		"revenueDonationBasisPoints":          &revenueSplit.DonationBasisPoints,
+		"revenueRequesterCashbackBasisPoints": &revenueSplit.RequesterCashbackBasisPoints,
+	} {
+		value, present := secrets.Get(key)
+		if !present {
+			continue
+		}
+		*basisPoints, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			mainLog.Fatalln("invalid "+key+":", err)
+		}
+	}
+
+	rewardDustFile, present := secrets.Get("rewardDustFile")
+	if !present {
+		mainLog.Println("Reward dust file path not present in keybox, will not persist reward dust")
+	}
+
+	vouchersFile, present := secrets.Get("vouchersFile")
+	if !present {
+		mainLog.Println("Vouchers file path not present in keybox, will not persist vouchers")
+	}
+
+	rewardLedgerFile, present := secrets.Get("rewardLedgerFile")
+	if !present {
+		mainLog.Println("Reward ledger file path not present in keybox, will not persist reward ledger")
+	}
+
+	channels, err := buildChannelConfigs(secrets)
+	if err != nil {
+		mainLog.Fatalln(err)
+	}
+
 	jwtManager = server.NewJWTManager(jwtKey)
-	apiServer, err := server.NewServer(ctx, apiLog, statsClient, wallet, youtubeAPIkey, jwtManager,
-		queueFile, bansFile, autoEnqueueVideoListFile, repAddress, ticketCheckPeriod,
-		ipCheckEndpoint, ipCheckToken, hCaptchaSecret, modLogWebhook)
+	apiServer, err := server.NewServer(ctx, apiLog, statsClient, wallet, jwtManager, server.ServerOptions{
+		YouTubeAPIKey:          youtubeAPIkey,
+		Channels:               channels,
+		BansFile:               bansFile,
+		RepAddress:             repAddress,
+		PaymentAccountPoolFile: paymentAccountPoolFile,
+		RefundPolicy:           refundPolicy,
+		RefundLedgerFile:       refundLedgerFile,
+		PricingFile:            pricingFile,
+		NodeWebsocketURL:       nodeWebsocketURL,
+		PrepaidBalancesFile:    prepaidBalancesFile,
+		RevenueSplit:           revenueSplit,
+		RewardDustFile:         rewardDustFile,
+		VouchersFile:           vouchersFile,
+		RewardLedgerFile:       rewardLedgerFile,
+		TicketCheckPeriod:      ticketCheckPeriod,
+		IPCheckEndpoint:        ipCheckEndpoint,
+		IPCheckToken:           ipCheckToken,
+		HCaptchaSecret:         hCaptchaSecret,
+		ModLogWebhook:          modLogWebhook,
+		DirectMediaHosts:       directMediaHosts,
+		RequesterQuota:         requesterQuota,
+		FairQueueInterleaving:  fairQueueInterleaving,
+		MediaRepeatWindow:      mediaRepeatWindow,
+	})
 	if err != nil {
 		mainLog.Fatalln(err)
 	}
@@ -220,6 +345,68 @@ func init() {
 	grpclog.SetLogger(apiLog)
 }
 
+// buildChannelConfigs returns the configuration of the main channel, taken from the top level of the keybox, followed
+// by that of the channels listed in additionalChannels, each taken from the box with the channel ID inside the
+// channels box
+func buildChannelConfigs(secrets *keybox.Keybox) ([]server.ChannelConfig, error) {
+	mainChannel := channelConfigFromKeybox(server.MainChannelID, secrets)
+	if mainChannel.Name == "" {
+		mainChannel.Name = "JungleTV"
+	}
+	configs := []server.ChannelConfig{mainChannel}
+
+	additionalChannels, present := secrets.Get("additionalChannels")
+	if !present || additionalChannels == "" {
+		return configs, nil
+	}
+	channelsBox, present := secrets.GetBox("channels")
+	if !present {
+		return nil, stacktrace.NewError("channels box not present in keybox")
+	}
+	for _, id := range strings.Split(additionalChannels, ",") {
+		id = strings.TrimSpace(id)
+		channelBox, present := channelsBox.GetBox(id)
+		if !present {
+			return nil, stacktrace.NewError("configuration for channel %s not present in keybox", id)
+		}
+		config := channelConfigFromKeybox(id, channelBox)
+		if config.Name == "" {
+			config.Name = id
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+func channelConfigFromKeybox(id string, box *keybox.Keybox) server.ChannelConfig {
+	config := server.ChannelConfig{
+		ID: id,
+	}
+	var present bool
+	config.Name, _ = box.Get("channelName")
+
+	config.QueueFile, present = box.Get("queueFile")
+	if !present {
+		mainLog.Printf("Queue file path for channel %s not present in keybox, will not persist queue", id)
+	}
+
+	config.AutoEnqueueVideoListFile, present = box.Get("autoEnqueueVideosFile")
+	if !present {
+		mainLog.Printf("Auto enqueue videos file path for channel %s not present in keybox, will not auto enqueue videos", id)
+	}
+
+	config.PlayedMediaHistoryFile, present = box.Get("playedMediaHistoryFile")
+	if !present {
+		mainLog.Printf("Played media history file path for channel %s not present in keybox, will not persist played media history", id)
+	}
+
+	config.TicketsFile, present = box.Get("ticketsFile")
+	if !present {
+		mainLog.Printf("Tickets file path for channel %s not present in keybox, will not persist tickets pending payment", id)
+	}
+	return config
+}
+
 func buildWallet(secrets *keybox.Keybox) (*wallet.Wallet, error) {
 	seedHex, present := secrets.Get("walletSeed")
 	if !present {
@@ -119,6 +119,162 @@ func (UserRole) EnumDescriptor() ([]byte, []int) {
 	return file_jungletv_proto_rawDescGZIP(), []int{1}
 }
 
+type RewardDistributionStatus int32
+
+const (
+	RewardDistributionStatus_DISTRIBUTED RewardDistributionStatus = 0
+	// the budget is carried over to the next distribution
+	RewardDistributionStatus_DISTRIBUTION_FAILED RewardDistributionStatus = 1
+	// there were no eligible spectators and the budget was returned to who paid for the entry
+	RewardDistributionStatus_REIMBURSED_TO_PAYERS RewardDistributionStatus = 2
+	// the budget was too small to reward each eligible spectator and was carried over to the next distribution
+	RewardDistributionStatus_CARRIED_OVER RewardDistributionStatus = 3
+)
+
+// Enum value maps for RewardDistributionStatus.
+var (
+	RewardDistributionStatus_name = map[int32]string{
+		0: "DISTRIBUTED",
+		1: "DISTRIBUTION_FAILED",
+		2: "REIMBURSED_TO_PAYERS",
+		3: "CARRIED_OVER",
+	}
+	RewardDistributionStatus_value = map[string]int32{
+		"DISTRIBUTED":          0,
+		"DISTRIBUTION_FAILED":  1,
+		"REIMBURSED_TO_PAYERS": 2,
+		"CARRIED_OVER":         3,
+	}
+)
+
+func (x RewardDistributionStatus) Enum() *RewardDistributionStatus {
+	p := new(RewardDistributionStatus)
+	*p = x
+	return p
+}
+
+func (x RewardDistributionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RewardDistributionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_jungletv_proto_enumTypes[2].Descriptor()
+}
+
+func (RewardDistributionStatus) Type() protoreflect.EnumType {
+	return &file_jungletv_proto_enumTypes[2]
+}
+
+func (x RewardDistributionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RewardDistributionStatus.Descriptor instead.
+func (RewardDistributionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{2}
+}
+
+type QueueEntryMovement int32
+
+const (
+	QueueEntryMovement_MOVE_UP          QueueEntryMovement = 0
+	QueueEntryMovement_MOVE_DOWN        QueueEntryMovement = 1
+	QueueEntryMovement_MOVE_TO_POSITION QueueEntryMovement = 2
+	QueueEntryMovement_SWAP             QueueEntryMovement = 3
+)
+
+// Enum value maps for QueueEntryMovement.
+var (
+	QueueEntryMovement_name = map[int32]string{
+		0: "MOVE_UP",
+		1: "MOVE_DOWN",
+		2: "MOVE_TO_POSITION",
+		3: "SWAP",
+	}
+	QueueEntryMovement_value = map[string]int32{
+		"MOVE_UP":          0,
+		"MOVE_DOWN":        1,
+		"MOVE_TO_POSITION": 2,
+		"SWAP":             3,
+	}
+)
+
+func (x QueueEntryMovement) Enum() *QueueEntryMovement {
+	p := new(QueueEntryMovement)
+	*p = x
+	return p
+}
+
+func (x QueueEntryMovement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueEntryMovement) Descriptor() protoreflect.EnumDescriptor {
+	return file_jungletv_proto_enumTypes[3].Descriptor()
+}
+
+func (QueueEntryMovement) Type() protoreflect.EnumType {
+	return &file_jungletv_proto_enumTypes[3]
+}
+
+func (x QueueEntryMovement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueEntryMovement.Descriptor instead.
+func (QueueEntryMovement) EnumDescriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{3}
+}
+
+type VoucherType int32
+
+const (
+	VoucherType_PERCENTAGE_DISCOUNT VoucherType = 0
+	VoucherType_FIXED_DISCOUNT      VoucherType = 1
+	VoucherType_FREE_ENQUEUE        VoucherType = 2
+)
+
+// Enum value maps for VoucherType.
+var (
+	VoucherType_name = map[int32]string{
+		0: "PERCENTAGE_DISCOUNT",
+		1: "FIXED_DISCOUNT",
+		2: "FREE_ENQUEUE",
+	}
+	VoucherType_value = map[string]int32{
+		"PERCENTAGE_DISCOUNT": 0,
+		"FIXED_DISCOUNT":      1,
+		"FREE_ENQUEUE":        2,
+	}
+)
+
+func (x VoucherType) Enum() *VoucherType {
+	p := new(VoucherType)
+	*p = x
+	return p
+}
+
+func (x VoucherType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoucherType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jungletv_proto_enumTypes[4].Descriptor()
+}
+
+func (VoucherType) Type() protoreflect.EnumType {
+	return &file_jungletv_proto_enumTypes[4]
+}
+
+func (x VoucherType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoucherType.Descriptor instead.
+func (VoucherType) EnumDescriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{4}
+}
+
 type ForcedTicketEnqueueType int32
 
 const (
@@ -152,11 +308,11 @@ func (x ForcedTicketEnqueueType) String() string {
 }
 
 func (ForcedTicketEnqueueType) Descriptor() protoreflect.EnumDescriptor {
-	return file_jungletv_proto_enumTypes[2].Descriptor()
+	return file_jungletv_proto_enumTypes[5].Descriptor()
 }
 
 func (ForcedTicketEnqueueType) Type() protoreflect.EnumType {
-	return &file_jungletv_proto_enumTypes[2]
+	return &file_jungletv_proto_enumTypes[5]
 }
 
 func (x ForcedTicketEnqueueType) Number() protoreflect.EnumNumber {
@@ -165,7 +321,7 @@ func (x ForcedTicketEnqueueType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ForcedTicketEnqueueType.Descriptor instead.
 func (ForcedTicketEnqueueType) EnumDescriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{2}
+	return file_jungletv_proto_rawDescGZIP(), []int{5}
 }
 
 type ChatDisabledReason int32
@@ -198,11 +354,11 @@ func (x ChatDisabledReason) String() string {
 }
 
 func (ChatDisabledReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_jungletv_proto_enumTypes[3].Descriptor()
+	return file_jungletv_proto_enumTypes[6].Descriptor()
 }
 
 func (ChatDisabledReason) Type() protoreflect.EnumType {
-	return &file_jungletv_proto_enumTypes[3]
+	return &file_jungletv_proto_enumTypes[6]
 }
 
 func (x ChatDisabledReason) Number() protoreflect.EnumNumber {
@@ -211,7 +367,7 @@ func (x ChatDisabledReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChatDisabledReason.Descriptor instead.
 func (ChatDisabledReason) EnumDescriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{3}
+	return file_jungletv_proto_rawDescGZIP(), []int{6}
 }
 
 type AllowedVideoEnqueuingType int32
@@ -247,11 +403,11 @@ func (x AllowedVideoEnqueuingType) String() string {
 }
 
 func (AllowedVideoEnqueuingType) Descriptor() protoreflect.EnumDescriptor {
-	return file_jungletv_proto_enumTypes[4].Descriptor()
+	return file_jungletv_proto_enumTypes[7].Descriptor()
 }
 
 func (AllowedVideoEnqueuingType) Type() protoreflect.EnumType {
-	return &file_jungletv_proto_enumTypes[4]
+	return &file_jungletv_proto_enumTypes[7]
 }
 
 func (x AllowedVideoEnqueuingType) Number() protoreflect.EnumNumber {
@@ -260,7 +416,7 @@ func (x AllowedVideoEnqueuingType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AllowedVideoEnqueuingType.Descriptor instead.
 func (AllowedVideoEnqueuingType) EnumDescriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{4}
+	return file_jungletv_proto_rawDescGZIP(), []int{7}
 }
 
 type PermissionLevel int32
@@ -296,11 +452,11 @@ func (x PermissionLevel) String() string {
 }
 
 func (PermissionLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_jungletv_proto_enumTypes[5].Descriptor()
+	return file_jungletv_proto_enumTypes[8].Descriptor()
 }
 
 func (PermissionLevel) Type() protoreflect.EnumType {
-	return &file_jungletv_proto_enumTypes[5]
+	return &file_jungletv_proto_enumTypes[8]
 }
 
 func (x PermissionLevel) Number() protoreflect.EnumNumber {
@@ -309,7 +465,56 @@ func (x PermissionLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PermissionLevel.Descriptor instead.
 func (PermissionLevel) EnumDescriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{5}
+	return file_jungletv_proto_rawDescGZIP(), []int{8}
+}
+
+type PrepaidBalanceLedgerEntryType int32
+
+const (
+	PrepaidBalanceLedgerEntryType_DEPOSIT        PrepaidBalanceLedgerEntryType = 0
+	PrepaidBalanceLedgerEntryType_TICKET_PAYMENT PrepaidBalanceLedgerEntryType = 1
+	PrepaidBalanceLedgerEntryType_WITHDRAWAL     PrepaidBalanceLedgerEntryType = 2
+)
+
+// Enum value maps for PrepaidBalanceLedgerEntryType.
+var (
+	PrepaidBalanceLedgerEntryType_name = map[int32]string{
+		0: "DEPOSIT",
+		1: "TICKET_PAYMENT",
+		2: "WITHDRAWAL",
+	}
+	PrepaidBalanceLedgerEntryType_value = map[string]int32{
+		"DEPOSIT":        0,
+		"TICKET_PAYMENT": 1,
+		"WITHDRAWAL":     2,
+	}
+)
+
+func (x PrepaidBalanceLedgerEntryType) Enum() *PrepaidBalanceLedgerEntryType {
+	p := new(PrepaidBalanceLedgerEntryType)
+	*p = x
+	return p
+}
+
+func (x PrepaidBalanceLedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrepaidBalanceLedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jungletv_proto_enumTypes[9].Descriptor()
+}
+
+func (PrepaidBalanceLedgerEntryType) Type() protoreflect.EnumType {
+	return &file_jungletv_proto_enumTypes[9]
+}
+
+func (x PrepaidBalanceLedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrepaidBalanceLedgerEntryType.Descriptor instead.
+func (PrepaidBalanceLedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{9}
 }
 
 type SignInRequest struct {
@@ -659,6 +864,9 @@ type EnqueueYouTubeVideoData struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_offset and end_offset optionally restrict playback to a segment of the video
+	StartOffset *durationpb.Duration `protobuf:"bytes,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   *durationpb.Duration `protobuf:"bytes,3,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
 }
 
 func (x *EnqueueYouTubeVideoData) Reset() {
@@ -700,6 +908,138 @@ func (x *EnqueueYouTubeVideoData) GetId() string {
 	return ""
 }
 
+func (x *EnqueueYouTubeVideoData) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *EnqueueYouTubeVideoData) GetEndOffset() *durationpb.Duration {
+	if x != nil {
+		return x.EndOffset
+	}
+	return nil
+}
+
+type EnqueueDirectMediaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url       string               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title     string               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Duration  *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	AudioOnly bool                 `protobuf:"varint,4,opt,name=audio_only,json=audioOnly,proto3" json:"audio_only,omitempty"`
+}
+
+func (x *EnqueueDirectMediaData) Reset() {
+	*x = EnqueueDirectMediaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueDirectMediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueDirectMediaData) ProtoMessage() {}
+
+func (x *EnqueueDirectMediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueDirectMediaData.ProtoReflect.Descriptor instead.
+func (*EnqueueDirectMediaData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EnqueueDirectMediaData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EnqueueDirectMediaData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EnqueueDirectMediaData) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *EnqueueDirectMediaData) GetAudioOnly() bool {
+	if x != nil {
+		return x.AudioOnly
+	}
+	return false
+}
+
+type EnqueueYouTubePlaylistData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnqueueYouTubePlaylistData) Reset() {
+	*x = EnqueueYouTubePlaylistData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueYouTubePlaylistData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueYouTubePlaylistData) ProtoMessage() {}
+
+func (x *EnqueueYouTubePlaylistData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueYouTubePlaylistData.ProtoReflect.Descriptor instead.
+func (*EnqueueYouTubePlaylistData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EnqueueYouTubePlaylistData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // EnqueueStubData allows us to prepare and confirm that polymorphism is working properly
 type EnqueueStubData struct {
 	state         protoimpl.MessageState
@@ -710,7 +1050,7 @@ type EnqueueStubData struct {
 func (x *EnqueueStubData) Reset() {
 	*x = EnqueueStubData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[7]
+		mi := &file_jungletv_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -723,7 +1063,7 @@ func (x *EnqueueStubData) String() string {
 func (*EnqueueStubData) ProtoMessage() {}
 
 func (x *EnqueueStubData) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[7]
+	mi := &file_jungletv_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +1076,7 @@ func (x *EnqueueStubData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueStubData.ProtoReflect.Descriptor instead.
 func (*EnqueueStubData) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{7}
+	return file_jungletv_proto_rawDescGZIP(), []int{9}
 }
 
 type EnqueueMediaRequest struct {
@@ -748,13 +1088,31 @@ type EnqueueMediaRequest struct {
 	// Types that are assignable to MediaInfo:
 	//	*EnqueueMediaRequest_StubData
 	//	*EnqueueMediaRequest_YoutubeVideoData
+	//	*EnqueueMediaRequest_DirectMediaData
+	//	*EnqueueMediaRequest_YoutubePlaylistData
 	MediaInfo isEnqueueMediaRequest_MediaInfo `protobuf_oneof:"media_info"`
+	ChannelId string                          `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pay_from_balance pays the ticket right away from the prepaid balance of the signed-in user,
+	// at the price of balance_payment_tier
+	PayFromBalance     bool                    `protobuf:"varint,7,opt,name=pay_from_balance,json=payFromBalance,proto3" json:"pay_from_balance,omitempty"`
+	BalancePaymentTier ForcedTicketEnqueueType `protobuf:"varint,8,opt,name=balance_payment_tier,json=balancePaymentTier,proto3,enum=jungletv.ForcedTicketEnqueueType" json:"balance_payment_tier,omitempty"`
+	// crowdfunded tickets accept payments from multiple senders and stay open for longer, until the price of
+	// crowdfunding_target_tier is reached
+	Crowdfunded            bool                    `protobuf:"varint,9,opt,name=crowdfunded,proto3" json:"crowdfunded,omitempty"`
+	CrowdfundingTargetTier ForcedTicketEnqueueType `protobuf:"varint,10,opt,name=crowdfunding_target_tier,json=crowdfundingTargetTier,proto3,enum=jungletv.ForcedTicketEnqueueType" json:"crowdfunding_target_tier,omitempty"`
+	// credited_to is a reward address to show as having enqueued the media, instead of the requester (e.g. to dedicate
+	// it to someone). Rewards and reimbursements still concern whoever paid
+	CreditedTo string `protobuf:"bytes,11,opt,name=credited_to,json=creditedTo,proto3" json:"credited_to,omitempty"`
+	// anonymous hides who enqueued the media, unless it is credited to someone else
+	Anonymous bool `protobuf:"varint,12,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	// voucher_code applies a promotional voucher, which requires being signed in
+	VoucherCode string `protobuf:"bytes,13,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
 }
 
 func (x *EnqueueMediaRequest) Reset() {
 	*x = EnqueueMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[8]
+		mi := &file_jungletv_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -767,7 +1125,7 @@ func (x *EnqueueMediaRequest) String() string {
 func (*EnqueueMediaRequest) ProtoMessage() {}
 
 func (x *EnqueueMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[8]
+	mi := &file_jungletv_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +1138,7 @@ func (x *EnqueueMediaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueMediaRequest.ProtoReflect.Descriptor instead.
 func (*EnqueueMediaRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{8}
+	return file_jungletv_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EnqueueMediaRequest) GetUnskippable() bool {
@@ -811,22 +1169,104 @@ func (x *EnqueueMediaRequest) GetYoutubeVideoData() *EnqueueYouTubeVideoData {
 	return nil
 }
 
-type isEnqueueMediaRequest_MediaInfo interface {
-	isEnqueueMediaRequest_MediaInfo()
+func (x *EnqueueMediaRequest) GetDirectMediaData() *EnqueueDirectMediaData {
+	if x, ok := x.GetMediaInfo().(*EnqueueMediaRequest_DirectMediaData); ok {
+		return x.DirectMediaData
+	}
+	return nil
 }
 
-type EnqueueMediaRequest_StubData struct {
-	StubData *EnqueueStubData `protobuf:"bytes,2,opt,name=stub_data,json=stubData,proto3,oneof"`
+func (x *EnqueueMediaRequest) GetYoutubePlaylistData() *EnqueueYouTubePlaylistData {
+	if x, ok := x.GetMediaInfo().(*EnqueueMediaRequest_YoutubePlaylistData); ok {
+		return x.YoutubePlaylistData
+	}
+	return nil
 }
 
-type EnqueueMediaRequest_YoutubeVideoData struct {
-	YoutubeVideoData *EnqueueYouTubeVideoData `protobuf:"bytes,3,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
+func (x *EnqueueMediaRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (*EnqueueMediaRequest_StubData) isEnqueueMediaRequest_MediaInfo() {}
+func (x *EnqueueMediaRequest) GetPayFromBalance() bool {
+	if x != nil {
+		return x.PayFromBalance
+	}
+	return false
+}
+
+func (x *EnqueueMediaRequest) GetBalancePaymentTier() ForcedTicketEnqueueType {
+	if x != nil {
+		return x.BalancePaymentTier
+	}
+	return ForcedTicketEnqueueType_ENQUEUE
+}
+
+func (x *EnqueueMediaRequest) GetCrowdfunded() bool {
+	if x != nil {
+		return x.Crowdfunded
+	}
+	return false
+}
+
+func (x *EnqueueMediaRequest) GetCrowdfundingTargetTier() ForcedTicketEnqueueType {
+	if x != nil {
+		return x.CrowdfundingTargetTier
+	}
+	return ForcedTicketEnqueueType_ENQUEUE
+}
+
+func (x *EnqueueMediaRequest) GetCreditedTo() string {
+	if x != nil {
+		return x.CreditedTo
+	}
+	return ""
+}
+
+func (x *EnqueueMediaRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *EnqueueMediaRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+type isEnqueueMediaRequest_MediaInfo interface {
+	isEnqueueMediaRequest_MediaInfo()
+}
+
+type EnqueueMediaRequest_StubData struct {
+	StubData *EnqueueStubData `protobuf:"bytes,2,opt,name=stub_data,json=stubData,proto3,oneof"`
+}
+
+type EnqueueMediaRequest_YoutubeVideoData struct {
+	YoutubeVideoData *EnqueueYouTubeVideoData `protobuf:"bytes,3,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
+}
+
+type EnqueueMediaRequest_DirectMediaData struct {
+	DirectMediaData *EnqueueDirectMediaData `protobuf:"bytes,4,opt,name=direct_media_data,json=directMediaData,proto3,oneof"`
+}
+
+type EnqueueMediaRequest_YoutubePlaylistData struct {
+	YoutubePlaylistData *EnqueueYouTubePlaylistData `protobuf:"bytes,5,opt,name=youtube_playlist_data,json=youtubePlaylistData,proto3,oneof"`
+}
+
+func (*EnqueueMediaRequest_StubData) isEnqueueMediaRequest_MediaInfo() {}
 
 func (*EnqueueMediaRequest_YoutubeVideoData) isEnqueueMediaRequest_MediaInfo() {}
 
+func (*EnqueueMediaRequest_DirectMediaData) isEnqueueMediaRequest_MediaInfo() {}
+
+func (*EnqueueMediaRequest_YoutubePlaylistData) isEnqueueMediaRequest_MediaInfo() {}
+
 type EnqueueMediaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -841,7 +1281,7 @@ type EnqueueMediaResponse struct {
 func (x *EnqueueMediaResponse) Reset() {
 	*x = EnqueueMediaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[9]
+		mi := &file_jungletv_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -854,7 +1294,7 @@ func (x *EnqueueMediaResponse) String() string {
 func (*EnqueueMediaResponse) ProtoMessage() {}
 
 func (x *EnqueueMediaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[9]
+	mi := &file_jungletv_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1307,7 @@ func (x *EnqueueMediaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueMediaResponse.ProtoReflect.Descriptor instead.
 func (*EnqueueMediaResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{9}
+	return file_jungletv_proto_rawDescGZIP(), []int{11}
 }
 
 func (m *EnqueueMediaResponse) GetEnqueueResponse() isEnqueueMediaResponse_EnqueueResponse {
@@ -918,7 +1358,7 @@ type EnqueueMediaFailure struct {
 func (x *EnqueueMediaFailure) Reset() {
 	*x = EnqueueMediaFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[10]
+		mi := &file_jungletv_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1371,7 @@ func (x *EnqueueMediaFailure) String() string {
 func (*EnqueueMediaFailure) ProtoMessage() {}
 
 func (x *EnqueueMediaFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[10]
+	mi := &file_jungletv_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1384,7 @@ func (x *EnqueueMediaFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueMediaFailure.ProtoReflect.Descriptor instead.
 func (*EnqueueMediaFailure) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{10}
+	return file_jungletv_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EnqueueMediaFailure) GetFailureReason() string {
@@ -954,6 +1394,201 @@ func (x *EnqueueMediaFailure) GetFailureReason() string {
 	return ""
 }
 
+type GetEnqueuePricingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to PricingResponse:
+	//	*GetEnqueuePricingResponse_Quote
+	//	*GetEnqueuePricingResponse_Failure
+	PricingResponse isGetEnqueuePricingResponse_PricingResponse `protobuf_oneof:"pricing_response"`
+}
+
+func (x *GetEnqueuePricingResponse) Reset() {
+	*x = GetEnqueuePricingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnqueuePricingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnqueuePricingResponse) ProtoMessage() {}
+
+func (x *GetEnqueuePricingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnqueuePricingResponse.ProtoReflect.Descriptor instead.
+func (*GetEnqueuePricingResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *GetEnqueuePricingResponse) GetPricingResponse() isGetEnqueuePricingResponse_PricingResponse {
+	if m != nil {
+		return m.PricingResponse
+	}
+	return nil
+}
+
+func (x *GetEnqueuePricingResponse) GetQuote() *EnqueuePricingQuote {
+	if x, ok := x.GetPricingResponse().(*GetEnqueuePricingResponse_Quote); ok {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *GetEnqueuePricingResponse) GetFailure() *EnqueueMediaFailure {
+	if x, ok := x.GetPricingResponse().(*GetEnqueuePricingResponse_Failure); ok {
+		return x.Failure
+	}
+	return nil
+}
+
+type isGetEnqueuePricingResponse_PricingResponse interface {
+	isGetEnqueuePricingResponse_PricingResponse()
+}
+
+type GetEnqueuePricingResponse_Quote struct {
+	Quote *EnqueuePricingQuote `protobuf:"bytes,1,opt,name=quote,proto3,oneof"`
+}
+
+type GetEnqueuePricingResponse_Failure struct {
+	Failure *EnqueueMediaFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*GetEnqueuePricingResponse_Quote) isGetEnqueuePricingResponse_PricingResponse() {}
+
+func (*GetEnqueuePricingResponse_Failure) isGetEnqueuePricingResponse_PricingResponse() {}
+
+// EnqueuePricingQuote contains what the prices of an EnqueueMediaTicket for the same media would be, had it been
+// requested at the same time. Prices may change by the time the media is actually enqueued.
+// Prices take the voucher_code of the request into account, without redeeming the voucher. With free enqueue vouchers,
+// the enqueue_price is zero
+type EnqueuePricingQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId                     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	EnqueuePrice                  string                 `protobuf:"bytes,2,opt,name=enqueue_price,json=enqueuePrice,proto3" json:"enqueue_price,omitempty"`
+	PlayNextPrice                 string                 `protobuf:"bytes,3,opt,name=play_next_price,json=playNextPrice,proto3" json:"play_next_price,omitempty"`
+	PlayNowPrice                  string                 `protobuf:"bytes,4,opt,name=play_now_price,json=playNowPrice,proto3" json:"play_now_price,omitempty"`
+	Unskippable                   bool                   `protobuf:"varint,5,opt,name=unskippable,proto3" json:"unskippable,omitempty"`
+	CurrentlyPlayingIsUnskippable bool                   `protobuf:"varint,6,opt,name=currently_playing_is_unskippable,json=currentlyPlayingIsUnskippable,proto3" json:"currently_playing_is_unskippable,omitempty"`
+	EnqueueProjectedStart         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=enqueue_projected_start,json=enqueueProjectedStart,proto3" json:"enqueue_projected_start,omitempty"`
+	PlayNextProjectedStart        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=play_next_projected_start,json=playNextProjectedStart,proto3" json:"play_next_projected_start,omitempty"`
+	PlayNowProjectedStart         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=play_now_projected_start,json=playNowProjectedStart,proto3" json:"play_now_projected_start,omitempty"`
+}
+
+func (x *EnqueuePricingQuote) Reset() {
+	*x = EnqueuePricingQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueuePricingQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePricingQuote) ProtoMessage() {}
+
+func (x *EnqueuePricingQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePricingQuote.ProtoReflect.Descriptor instead.
+func (*EnqueuePricingQuote) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EnqueuePricingQuote) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *EnqueuePricingQuote) GetEnqueuePrice() string {
+	if x != nil {
+		return x.EnqueuePrice
+	}
+	return ""
+}
+
+func (x *EnqueuePricingQuote) GetPlayNextPrice() string {
+	if x != nil {
+		return x.PlayNextPrice
+	}
+	return ""
+}
+
+func (x *EnqueuePricingQuote) GetPlayNowPrice() string {
+	if x != nil {
+		return x.PlayNowPrice
+	}
+	return ""
+}
+
+func (x *EnqueuePricingQuote) GetUnskippable() bool {
+	if x != nil {
+		return x.Unskippable
+	}
+	return false
+}
+
+func (x *EnqueuePricingQuote) GetCurrentlyPlayingIsUnskippable() bool {
+	if x != nil {
+		return x.CurrentlyPlayingIsUnskippable
+	}
+	return false
+}
+
+func (x *EnqueuePricingQuote) GetEnqueueProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueueProjectedStart
+	}
+	return nil
+}
+
+func (x *EnqueuePricingQuote) GetPlayNextProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayNextProjectedStart
+	}
+	return nil
+}
+
+func (x *EnqueuePricingQuote) GetPlayNowProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayNowProjectedStart
+	}
+	return nil
+}
+
 type EnqueueMediaTicket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -970,13 +1605,24 @@ type EnqueueMediaTicket struct {
 	CurrentlyPlayingIsUnskippable bool                     `protobuf:"varint,9,opt,name=currently_playing_is_unskippable,json=currentlyPlayingIsUnskippable,proto3" json:"currently_playing_is_unskippable,omitempty"`
 	// Types that are assignable to MediaInfo:
 	//	*EnqueueMediaTicket_YoutubeVideoData
-	MediaInfo isEnqueueMediaTicket_MediaInfo `protobuf_oneof:"media_info"`
+	//	*EnqueueMediaTicket_DirectMediaData
+	//	*EnqueueMediaTicket_YoutubePlaylistData
+	MediaInfo              isEnqueueMediaTicket_MediaInfo `protobuf_oneof:"media_info"`
+	ChannelId              string                         `protobuf:"bytes,13,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	EnqueueProjectedStart  *timestamppb.Timestamp         `protobuf:"bytes,14,opt,name=enqueue_projected_start,json=enqueueProjectedStart,proto3" json:"enqueue_projected_start,omitempty"`
+	PlayNextProjectedStart *timestamppb.Timestamp         `protobuf:"bytes,15,opt,name=play_next_projected_start,json=playNextProjectedStart,proto3" json:"play_next_projected_start,omitempty"`
+	PlayNowProjectedStart  *timestamppb.Timestamp         `protobuf:"bytes,16,opt,name=play_now_projected_start,json=playNowProjectedStart,proto3" json:"play_now_projected_start,omitempty"`
+	Crowdfunded            bool                           `protobuf:"varint,17,opt,name=crowdfunded,proto3" json:"crowdfunded,omitempty"`
+	CrowdfundingTargetTier ForcedTicketEnqueueType        `protobuf:"varint,18,opt,name=crowdfunding_target_tier,json=crowdfundingTargetTier,proto3,enum=jungletv.ForcedTicketEnqueueType" json:"crowdfunding_target_tier,omitempty"`
+	CreditedTo             string                         `protobuf:"bytes,19,opt,name=credited_to,json=creditedTo,proto3" json:"credited_to,omitempty"`
+	Anonymous              bool                           `protobuf:"varint,20,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	VoucherCode            string                         `protobuf:"bytes,21,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
 }
 
 func (x *EnqueueMediaTicket) Reset() {
 	*x = EnqueueMediaTicket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[11]
+		mi := &file_jungletv_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -989,7 +1635,7 @@ func (x *EnqueueMediaTicket) String() string {
 func (*EnqueueMediaTicket) ProtoMessage() {}
 
 func (x *EnqueueMediaTicket) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[11]
+	mi := &file_jungletv_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1648,7 @@ func (x *EnqueueMediaTicket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueMediaTicket.ProtoReflect.Descriptor instead.
 func (*EnqueueMediaTicket) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{11}
+	return file_jungletv_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EnqueueMediaTicket) GetId() string {
@@ -1082,59 +1728,148 @@ func (x *EnqueueMediaTicket) GetYoutubeVideoData() *QueueYouTubeVideoData {
 	return nil
 }
 
-type isEnqueueMediaTicket_MediaInfo interface {
-	isEnqueueMediaTicket_MediaInfo()
+func (x *EnqueueMediaTicket) GetDirectMediaData() *QueueDirectMediaData {
+	if x, ok := x.GetMediaInfo().(*EnqueueMediaTicket_DirectMediaData); ok {
+		return x.DirectMediaData
+	}
+	return nil
 }
 
-type EnqueueMediaTicket_YoutubeVideoData struct {
-	YoutubeVideoData *QueueYouTubeVideoData `protobuf:"bytes,10,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
+func (x *EnqueueMediaTicket) GetYoutubePlaylistData() *QueueYouTubePlaylistData {
+	if x, ok := x.GetMediaInfo().(*EnqueueMediaTicket_YoutubePlaylistData); ok {
+		return x.YoutubePlaylistData
+	}
+	return nil
 }
 
-func (*EnqueueMediaTicket_YoutubeVideoData) isEnqueueMediaTicket_MediaInfo() {}
-
-type MonitorTicketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TicketId string `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+func (x *EnqueueMediaTicket) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
 
-func (x *MonitorTicketRequest) Reset() {
-	*x = MonitorTicketRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EnqueueMediaTicket) GetEnqueueProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueueProjectedStart
 	}
+	return nil
 }
 
-func (x *MonitorTicketRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EnqueueMediaTicket) GetPlayNextProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayNextProjectedStart
+	}
+	return nil
 }
 
-func (*MonitorTicketRequest) ProtoMessage() {}
-
-func (x *MonitorTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *EnqueueMediaTicket) GetPlayNowProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayNowProjectedStart
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MonitorTicketRequest.ProtoReflect.Descriptor instead.
-func (*MonitorTicketRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{12}
+func (x *EnqueueMediaTicket) GetCrowdfunded() bool {
+	if x != nil {
+		return x.Crowdfunded
+	}
+	return false
 }
 
-func (x *MonitorTicketRequest) GetTicketId() string {
+func (x *EnqueueMediaTicket) GetCrowdfundingTargetTier() ForcedTicketEnqueueType {
 	if x != nil {
-		return x.TicketId
+		return x.CrowdfundingTargetTier
+	}
+	return ForcedTicketEnqueueType_ENQUEUE
+}
+
+func (x *EnqueueMediaTicket) GetCreditedTo() string {
+	if x != nil {
+		return x.CreditedTo
+	}
+	return ""
+}
+
+func (x *EnqueueMediaTicket) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *EnqueueMediaTicket) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+type isEnqueueMediaTicket_MediaInfo interface {
+	isEnqueueMediaTicket_MediaInfo()
+}
+
+type EnqueueMediaTicket_YoutubeVideoData struct {
+	YoutubeVideoData *QueueYouTubeVideoData `protobuf:"bytes,10,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
+}
+
+type EnqueueMediaTicket_DirectMediaData struct {
+	DirectMediaData *QueueDirectMediaData `protobuf:"bytes,11,opt,name=direct_media_data,json=directMediaData,proto3,oneof"`
+}
+
+type EnqueueMediaTicket_YoutubePlaylistData struct {
+	YoutubePlaylistData *QueueYouTubePlaylistData `protobuf:"bytes,12,opt,name=youtube_playlist_data,json=youtubePlaylistData,proto3,oneof"`
+}
+
+func (*EnqueueMediaTicket_YoutubeVideoData) isEnqueueMediaTicket_MediaInfo() {}
+
+func (*EnqueueMediaTicket_DirectMediaData) isEnqueueMediaTicket_MediaInfo() {}
+
+func (*EnqueueMediaTicket_YoutubePlaylistData) isEnqueueMediaTicket_MediaInfo() {}
+
+type MonitorTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId string `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+}
+
+func (x *MonitorTicketRequest) Reset() {
+	*x = MonitorTicketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorTicketRequest) ProtoMessage() {}
+
+func (x *MonitorTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorTicketRequest.ProtoReflect.Descriptor instead.
+func (*MonitorTicketRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MonitorTicketRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
 	}
 	return ""
 }
@@ -1144,13 +1879,14 @@ type ConsumeMediaRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParticipateInPow bool `protobuf:"varint,1,opt,name=participate_in_pow,json=participateInPow,proto3" json:"participate_in_pow,omitempty"`
+	ParticipateInPow bool   `protobuf:"varint,1,opt,name=participate_in_pow,json=participateInPow,proto3" json:"participate_in_pow,omitempty"`
+	ChannelId        string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
 func (x *ConsumeMediaRequest) Reset() {
 	*x = ConsumeMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[13]
+		mi := &file_jungletv_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1163,7 +1899,7 @@ func (x *ConsumeMediaRequest) String() string {
 func (*ConsumeMediaRequest) ProtoMessage() {}
 
 func (x *ConsumeMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[13]
+	mi := &file_jungletv_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,7 +1912,7 @@ func (x *ConsumeMediaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumeMediaRequest.ProtoReflect.Descriptor instead.
 func (*ConsumeMediaRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{13}
+	return file_jungletv_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ConsumeMediaRequest) GetParticipateInPow() bool {
@@ -1186,6 +1922,13 @@ func (x *ConsumeMediaRequest) GetParticipateInPow() bool {
 	return false
 }
 
+func (x *ConsumeMediaRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
 // NowPlayingStubData allows us to prepare and confirm that polymorphism is working properly
 type NowPlayingStubData struct {
 	state         protoimpl.MessageState
@@ -1196,7 +1939,7 @@ type NowPlayingStubData struct {
 func (x *NowPlayingStubData) Reset() {
 	*x = NowPlayingStubData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[14]
+		mi := &file_jungletv_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1952,7 @@ func (x *NowPlayingStubData) String() string {
 func (*NowPlayingStubData) ProtoMessage() {}
 
 func (x *NowPlayingStubData) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[14]
+	mi := &file_jungletv_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1965,7 @@ func (x *NowPlayingStubData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NowPlayingStubData.ProtoReflect.Descriptor instead.
 func (*NowPlayingStubData) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{14}
+	return file_jungletv_proto_rawDescGZIP(), []int{18}
 }
 
 type NowPlayingYouTubeVideoData struct {
@@ -1231,12 +1974,15 @@ type NowPlayingYouTubeVideoData struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the current_position of the checkpoint is a position in the whole video, between start_offset and end_offset
+	StartOffset *durationpb.Duration `protobuf:"bytes,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   *durationpb.Duration `protobuf:"bytes,3,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
 }
 
 func (x *NowPlayingYouTubeVideoData) Reset() {
 	*x = NowPlayingYouTubeVideoData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[15]
+		mi := &file_jungletv_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1249,7 +1995,7 @@ func (x *NowPlayingYouTubeVideoData) String() string {
 func (*NowPlayingYouTubeVideoData) ProtoMessage() {}
 
 func (x *NowPlayingYouTubeVideoData) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[15]
+	mi := &file_jungletv_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +2008,7 @@ func (x *NowPlayingYouTubeVideoData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NowPlayingYouTubeVideoData.ProtoReflect.Descriptor instead.
 func (*NowPlayingYouTubeVideoData) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{15}
+	return file_jungletv_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NowPlayingYouTubeVideoData) GetId() string {
@@ -1272,6 +2018,75 @@ func (x *NowPlayingYouTubeVideoData) GetId() string {
 	return ""
 }
 
+func (x *NowPlayingYouTubeVideoData) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *NowPlayingYouTubeVideoData) GetEndOffset() *durationpb.Duration {
+	if x != nil {
+		return x.EndOffset
+	}
+	return nil
+}
+
+type NowPlayingDirectMediaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url       string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	AudioOnly bool   `protobuf:"varint,2,opt,name=audio_only,json=audioOnly,proto3" json:"audio_only,omitempty"`
+}
+
+func (x *NowPlayingDirectMediaData) Reset() {
+	*x = NowPlayingDirectMediaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NowPlayingDirectMediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NowPlayingDirectMediaData) ProtoMessage() {}
+
+func (x *NowPlayingDirectMediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NowPlayingDirectMediaData.ProtoReflect.Descriptor instead.
+func (*NowPlayingDirectMediaData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NowPlayingDirectMediaData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NowPlayingDirectMediaData) GetAudioOnly() bool {
+	if x != nil {
+		return x.AudioOnly
+	}
+	return false
+}
+
 type MediaConsumptionCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1288,13 +2103,15 @@ type MediaConsumptionCheckpoint struct {
 	// Types that are assignable to MediaInfo:
 	//	*MediaConsumptionCheckpoint_StubData
 	//	*MediaConsumptionCheckpoint_YoutubeVideoData
+	//	*MediaConsumptionCheckpoint_DirectMediaData
 	MediaInfo isMediaConsumptionCheckpoint_MediaInfo `protobuf_oneof:"media_info"`
+	Paused    bool                                   `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (x *MediaConsumptionCheckpoint) Reset() {
 	*x = MediaConsumptionCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[16]
+		mi := &file_jungletv_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1307,7 +2124,7 @@ func (x *MediaConsumptionCheckpoint) String() string {
 func (*MediaConsumptionCheckpoint) ProtoMessage() {}
 
 func (x *MediaConsumptionCheckpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[16]
+	mi := &file_jungletv_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +2137,7 @@ func (x *MediaConsumptionCheckpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MediaConsumptionCheckpoint.ProtoReflect.Descriptor instead.
 func (*MediaConsumptionCheckpoint) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{16}
+	return file_jungletv_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MediaConsumptionCheckpoint) GetMediaPresent() bool {
@@ -1400,6 +2217,20 @@ func (x *MediaConsumptionCheckpoint) GetYoutubeVideoData() *NowPlayingYouTubeVid
 	return nil
 }
 
+func (x *MediaConsumptionCheckpoint) GetDirectMediaData() *NowPlayingDirectMediaData {
+	if x, ok := x.GetMediaInfo().(*MediaConsumptionCheckpoint_DirectMediaData); ok {
+		return x.DirectMediaData
+	}
+	return nil
+}
+
+func (x *MediaConsumptionCheckpoint) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
 type isMediaConsumptionCheckpoint_MediaInfo interface {
 	isMediaConsumptionCheckpoint_MediaInfo()
 }
@@ -1412,10 +2243,16 @@ type MediaConsumptionCheckpoint_YoutubeVideoData struct {
 	YoutubeVideoData *NowPlayingYouTubeVideoData `protobuf:"bytes,10,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
 }
 
+type MediaConsumptionCheckpoint_DirectMediaData struct {
+	DirectMediaData *NowPlayingDirectMediaData `protobuf:"bytes,11,opt,name=direct_media_data,json=directMediaData,proto3,oneof"`
+}
+
 func (*MediaConsumptionCheckpoint_StubData) isMediaConsumptionCheckpoint_MediaInfo() {}
 
 func (*MediaConsumptionCheckpoint_YoutubeVideoData) isMediaConsumptionCheckpoint_MediaInfo() {}
 
+func (*MediaConsumptionCheckpoint_DirectMediaData) isMediaConsumptionCheckpoint_MediaInfo() {}
+
 type ActivityChallenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1428,7 +2265,7 @@ type ActivityChallenge struct {
 func (x *ActivityChallenge) Reset() {
 	*x = ActivityChallenge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[17]
+		mi := &file_jungletv_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1441,7 +2278,7 @@ func (x *ActivityChallenge) String() string {
 func (*ActivityChallenge) ProtoMessage() {}
 
 func (x *ActivityChallenge) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[17]
+	mi := &file_jungletv_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +2291,7 @@ func (x *ActivityChallenge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityChallenge.ProtoReflect.Descriptor instead.
 func (*ActivityChallenge) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{17}
+	return file_jungletv_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ActivityChallenge) GetId() string {
@@ -1483,7 +2320,7 @@ type ProofOfWorkTask struct {
 func (x *ProofOfWorkTask) Reset() {
 	*x = ProofOfWorkTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[18]
+		mi := &file_jungletv_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1496,7 +2333,7 @@ func (x *ProofOfWorkTask) String() string {
 func (*ProofOfWorkTask) ProtoMessage() {}
 
 func (x *ProofOfWorkTask) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[18]
+	mi := &file_jungletv_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,7 +2346,7 @@ func (x *ProofOfWorkTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProofOfWorkTask.ProtoReflect.Descriptor instead.
 func (*ProofOfWorkTask) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{18}
+	return file_jungletv_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProofOfWorkTask) GetTarget() []byte {
@@ -1530,12 +2367,16 @@ type MonitorQueueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// when incremental is set, the first message is a snapshot and the following ones only contain what changed
+	Incremental bool `protobuf:"varint,2,opt,name=incremental,proto3" json:"incremental,omitempty"`
 }
 
 func (x *MonitorQueueRequest) Reset() {
 	*x = MonitorQueueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[19]
+		mi := &file_jungletv_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1548,7 +2389,7 @@ func (x *MonitorQueueRequest) String() string {
 func (*MonitorQueueRequest) ProtoMessage() {}
 
 func (x *MonitorQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[19]
+	mi := &file_jungletv_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,7 +2402,21 @@ func (x *MonitorQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonitorQueueRequest.ProtoReflect.Descriptor instead.
 func (*MonitorQueueRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{19}
+	return file_jungletv_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MonitorQueueRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *MonitorQueueRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
 }
 
 type Queue struct {
@@ -1569,14 +2424,21 @@ type Queue struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries     []*QueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	IsHeartbeat bool          `protobuf:"varint,2,opt,name=is_heartbeat,json=isHeartbeat,proto3" json:"is_heartbeat,omitempty"`
+	Entries        []*QueueEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	IsHeartbeat    bool              `protobuf:"varint,2,opt,name=is_heartbeat,json=isHeartbeat,proto3" json:"is_heartbeat,omitempty"`
+	ScheduledMedia []*ScheduledMedia `protobuf:"bytes,3,rep,name=scheduled_media,json=scheduledMedia,proto3" json:"scheduled_media,omitempty"`
+	// in incremental mode, sequence increases by one with every message that isn't a heartbeat.
+	// Heartbeats carry the sequence of the last update. Clients that see a gap should reconnect to get a snapshot
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// in incremental mode, messages without changes that aren't heartbeats are snapshots, which replace the whole
+	// state known to the client. The server sends a snapshot instead of changes whenever it is more efficient to do so
+	Changes *QueueChanges `protobuf:"bytes,5,opt,name=changes,proto3" json:"changes,omitempty"`
 }
 
 func (x *Queue) Reset() {
 	*x = Queue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[20]
+		mi := &file_jungletv_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +2451,7 @@ func (x *Queue) String() string {
 func (*Queue) ProtoMessage() {}
 
 func (x *Queue) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[20]
+	mi := &file_jungletv_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +2464,7 @@ func (x *Queue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Queue.ProtoReflect.Descriptor instead.
 func (*Queue) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{20}
+	return file_jungletv_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Queue) GetEntries() []*QueueEntry {
@@ -1619,34 +2481,58 @@ func (x *Queue) GetIsHeartbeat() bool {
 	return false
 }
 
-type QueueYouTubeVideoData struct {
+func (x *Queue) GetScheduledMedia() []*ScheduledMedia {
+	if x != nil {
+		return x.ScheduledMedia
+	}
+	return nil
+}
+
+func (x *Queue) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Queue) GetChanges() *QueueChanges {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type QueueChanges struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ThumbnailUrl string `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	ChannelTitle string `protobuf:"bytes,4,opt,name=channel_title,json=channelTitle,proto3" json:"channel_title,omitempty"`
+	// changes must be applied in order
+	Changes []*QueueChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// projected start times of all entries, in the order they are in after applying the changes
+	ProjectedStarts []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=projected_starts,json=projectedStarts,proto3" json:"projected_starts,omitempty"`
+	// when scheduled_media_changed is set, scheduled_media contains the entire new schedule
+	ScheduledMediaChanged bool              `protobuf:"varint,3,opt,name=scheduled_media_changed,json=scheduledMediaChanged,proto3" json:"scheduled_media_changed,omitempty"`
+	ScheduledMedia        []*ScheduledMedia `protobuf:"bytes,4,rep,name=scheduled_media,json=scheduledMedia,proto3" json:"scheduled_media,omitempty"`
 }
 
-func (x *QueueYouTubeVideoData) Reset() {
-	*x = QueueYouTubeVideoData{}
+func (x *QueueChanges) Reset() {
+	*x = QueueChanges{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[21]
+		mi := &file_jungletv_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueueYouTubeVideoData) String() string {
+func (x *QueueChanges) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueYouTubeVideoData) ProtoMessage() {}
+func (*QueueChanges) ProtoMessage() {}
 
-func (x *QueueYouTubeVideoData) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[21]
+func (x *QueueChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,71 +2543,70 @@ func (x *QueueYouTubeVideoData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueYouTubeVideoData.ProtoReflect.Descriptor instead.
-func (*QueueYouTubeVideoData) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueueChanges.ProtoReflect.Descriptor instead.
+func (*QueueChanges) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueueYouTubeVideoData) GetId() string {
+func (x *QueueChanges) GetChanges() []*QueueChange {
 	if x != nil {
-		return x.Id
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-func (x *QueueYouTubeVideoData) GetTitle() string {
+func (x *QueueChanges) GetProjectedStarts() []*timestamppb.Timestamp {
 	if x != nil {
-		return x.Title
+		return x.ProjectedStarts
 	}
-	return ""
+	return nil
 }
 
-func (x *QueueYouTubeVideoData) GetThumbnailUrl() string {
+func (x *QueueChanges) GetScheduledMediaChanged() bool {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.ScheduledMediaChanged
 	}
-	return ""
+	return false
 }
 
-func (x *QueueYouTubeVideoData) GetChannelTitle() string {
+func (x *QueueChanges) GetScheduledMedia() []*ScheduledMedia {
 	if x != nil {
-		return x.ChannelTitle
+		return x.ScheduledMedia
 	}
-	return ""
+	return nil
 }
 
-type QueueEntry struct {
+type QueueChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RequestedBy *User                `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	RequestCost string               `protobuf:"bytes,3,opt,name=request_cost,json=requestCost,proto3" json:"request_cost,omitempty"`
-	Length      *durationpb.Duration `protobuf:"bytes,4,opt,name=length,proto3" json:"length,omitempty"`
-	Unskippable bool                 `protobuf:"varint,5,opt,name=unskippable,proto3" json:"unskippable,omitempty"`
-	// Types that are assignable to MediaInfo:
-	//	*QueueEntry_YoutubeVideoData
-	MediaInfo isQueueEntry_MediaInfo `protobuf_oneof:"media_info"`
+	// Types that are assignable to Change:
+	//	*QueueChange_Inserted
+	//	*QueueChange_Removed
+	//	*QueueChange_Moved
+	//	*QueueChange_HeadAdvanced
+	//	*QueueChange_Updated
+	Change isQueueChange_Change `protobuf_oneof:"change"`
 }
 
-func (x *QueueEntry) Reset() {
-	*x = QueueEntry{}
+func (x *QueueChange) Reset() {
+	*x = QueueChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[22]
+		mi := &file_jungletv_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueueEntry) String() string {
+func (x *QueueChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueEntry) ProtoMessage() {}
+func (*QueueChange) ProtoMessage() {}
 
-func (x *QueueEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[22]
+func (x *QueueChange) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,96 +2617,113 @@ func (x *QueueEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
-func (*QueueEntry) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QueueChange.ProtoReflect.Descriptor instead.
+func (*QueueChange) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueueEntry) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *QueueChange) GetChange() isQueueChange_Change {
+	if m != nil {
+		return m.Change
 	}
-	return ""
+	return nil
 }
 
-func (x *QueueEntry) GetRequestedBy() *User {
-	if x != nil {
-		return x.RequestedBy
+func (x *QueueChange) GetInserted() *QueueEntryInserted {
+	if x, ok := x.GetChange().(*QueueChange_Inserted); ok {
+		return x.Inserted
 	}
 	return nil
 }
 
-func (x *QueueEntry) GetRequestCost() string {
-	if x != nil {
-		return x.RequestCost
+func (x *QueueChange) GetRemoved() *QueueEntryRemoved {
+	if x, ok := x.GetChange().(*QueueChange_Removed); ok {
+		return x.Removed
 	}
-	return ""
+	return nil
 }
 
-func (x *QueueEntry) GetLength() *durationpb.Duration {
-	if x != nil {
-		return x.Length
+func (x *QueueChange) GetMoved() *QueueEntryMoved {
+	if x, ok := x.GetChange().(*QueueChange_Moved); ok {
+		return x.Moved
 	}
 	return nil
 }
 
-func (x *QueueEntry) GetUnskippable() bool {
-	if x != nil {
-		return x.Unskippable
+func (x *QueueChange) GetHeadAdvanced() *QueueHeadAdvanced {
+	if x, ok := x.GetChange().(*QueueChange_HeadAdvanced); ok {
+		return x.HeadAdvanced
 	}
-	return false
+	return nil
 }
 
-func (m *QueueEntry) GetMediaInfo() isQueueEntry_MediaInfo {
-	if m != nil {
-		return m.MediaInfo
+func (x *QueueChange) GetUpdated() *QueueEntryUpdated {
+	if x, ok := x.GetChange().(*QueueChange_Updated); ok {
+		return x.Updated
 	}
 	return nil
 }
 
-func (x *QueueEntry) GetYoutubeVideoData() *QueueYouTubeVideoData {
-	if x, ok := x.GetMediaInfo().(*QueueEntry_YoutubeVideoData); ok {
-		return x.YoutubeVideoData
-	}
-	return nil
+type isQueueChange_Change interface {
+	isQueueChange_Change()
 }
 
-type isQueueEntry_MediaInfo interface {
-	isQueueEntry_MediaInfo()
+type QueueChange_Inserted struct {
+	Inserted *QueueEntryInserted `protobuf:"bytes,1,opt,name=inserted,proto3,oneof"`
 }
 
-type QueueEntry_YoutubeVideoData struct {
-	YoutubeVideoData *QueueYouTubeVideoData `protobuf:"bytes,6,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
+type QueueChange_Removed struct {
+	Removed *QueueEntryRemoved `protobuf:"bytes,2,opt,name=removed,proto3,oneof"`
 }
 
-func (*QueueEntry_YoutubeVideoData) isQueueEntry_MediaInfo() {}
+type QueueChange_Moved struct {
+	Moved *QueueEntryMoved `protobuf:"bytes,3,opt,name=moved,proto3,oneof"`
+}
 
-type User struct {
+type QueueChange_HeadAdvanced struct {
+	HeadAdvanced *QueueHeadAdvanced `protobuf:"bytes,4,opt,name=head_advanced,json=headAdvanced,proto3,oneof"`
+}
+
+type QueueChange_Updated struct {
+	Updated *QueueEntryUpdated `protobuf:"bytes,5,opt,name=updated,proto3,oneof"`
+}
+
+func (*QueueChange_Inserted) isQueueChange_Change() {}
+
+func (*QueueChange_Removed) isQueueChange_Change() {}
+
+func (*QueueChange_Moved) isQueueChange_Change() {}
+
+func (*QueueChange_HeadAdvanced) isQueueChange_Change() {}
+
+func (*QueueChange_Updated) isQueueChange_Change() {}
+
+type QueueEntryInserted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Roles   []UserRole `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=jungletv.UserRole" json:"roles,omitempty"`
+	Position uint32      `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Entry    *QueueEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *QueueEntryInserted) Reset() {
+	*x = QueueEntryInserted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[23]
+		mi := &file_jungletv_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *User) String() string {
+func (x *QueueEntryInserted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*QueueEntryInserted) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[23]
+func (x *QueueEntryInserted) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,48 +2734,50 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use QueueEntryInserted.ProtoReflect.Descriptor instead.
+func (*QueueEntryInserted) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *User) GetAddress() string {
+func (x *QueueEntryInserted) GetPosition() uint32 {
 	if x != nil {
-		return x.Address
+		return x.Position
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetRoles() []UserRole {
+func (x *QueueEntryInserted) GetEntry() *QueueEntry {
 	if x != nil {
-		return x.Roles
+		return x.Entry
 	}
 	return nil
 }
 
-type RewardInfoRequest struct {
+type QueueEntryRemoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RewardInfoRequest) Reset() {
-	*x = RewardInfoRequest{}
+func (x *QueueEntryRemoved) Reset() {
+	*x = QueueEntryRemoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[24]
+		mi := &file_jungletv_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardInfoRequest) String() string {
+func (x *QueueEntryRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardInfoRequest) ProtoMessage() {}
+func (*QueueEntryRemoved) ProtoMessage() {}
 
-func (x *RewardInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[24]
+func (x *QueueEntryRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,36 +2788,45 @@ func (x *RewardInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardInfoRequest.ProtoReflect.Descriptor instead.
-func (*RewardInfoRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use QueueEntryRemoved.ProtoReflect.Descriptor instead.
+func (*QueueEntryRemoved) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{29}
 }
 
-type RewardInfoResponse struct {
+func (x *QueueEntryRemoved) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type QueueEntryMoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardAddress string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// position the entry ends up in, counted after removing it from its previous position
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *RewardInfoResponse) Reset() {
-	*x = RewardInfoResponse{}
+func (x *QueueEntryMoved) Reset() {
+	*x = QueueEntryMoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[25]
+		mi := &file_jungletv_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardInfoResponse) String() string {
+func (x *QueueEntryMoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardInfoResponse) ProtoMessage() {}
+func (*QueueEntryMoved) ProtoMessage() {}
 
-func (x *RewardInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[25]
+func (x *QueueEntryMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,43 +2837,51 @@ func (x *RewardInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardInfoResponse.ProtoReflect.Descriptor instead.
-func (*RewardInfoResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use QueueEntryMoved.ProtoReflect.Descriptor instead.
+func (*QueueEntryMoved) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RewardInfoResponse) GetRewardAddress() string {
+func (x *QueueEntryMoved) GetId() string {
 	if x != nil {
-		return x.RewardAddress
+		return x.Id
 	}
 	return ""
 }
 
-type RemoveQueueEntryRequest struct {
+func (x *QueueEntryMoved) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type QueueHeadAdvanced struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// number of entries removed from the start of the queue
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RemoveQueueEntryRequest) Reset() {
-	*x = RemoveQueueEntryRequest{}
+func (x *QueueHeadAdvanced) Reset() {
+	*x = QueueHeadAdvanced{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[26]
+		mi := &file_jungletv_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveQueueEntryRequest) String() string {
+func (x *QueueHeadAdvanced) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveQueueEntryRequest) ProtoMessage() {}
+func (*QueueHeadAdvanced) ProtoMessage() {}
 
-func (x *RemoveQueueEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[26]
+func (x *QueueHeadAdvanced) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,41 +2892,43 @@ func (x *RemoveQueueEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveQueueEntryRequest.ProtoReflect.Descriptor instead.
-func (*RemoveQueueEntryRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use QueueHeadAdvanced.ProtoReflect.Descriptor instead.
+func (*QueueHeadAdvanced) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RemoveQueueEntryRequest) GetId() string {
+func (x *QueueHeadAdvanced) GetCount() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type RemoveQueueEntryResponse struct {
+type QueueEntryUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Entry *QueueEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *RemoveQueueEntryResponse) Reset() {
-	*x = RemoveQueueEntryResponse{}
+func (x *QueueEntryUpdated) Reset() {
+	*x = QueueEntryUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[27]
+		mi := &file_jungletv_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveQueueEntryResponse) String() string {
+func (x *QueueEntryUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveQueueEntryResponse) ProtoMessage() {}
+func (*QueueEntryUpdated) ProtoMessage() {}
 
-func (x *RemoveQueueEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[27]
+func (x *QueueEntryUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,37 +2939,47 @@ func (x *RemoveQueueEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveQueueEntryResponse.ProtoReflect.Descriptor instead.
-func (*RemoveQueueEntryResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use QueueEntryUpdated.ProtoReflect.Descriptor instead.
+func (*QueueEntryUpdated) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{32}
 }
 
-type ForciblyEnqueueTicketRequest struct {
+func (x *QueueEntryUpdated) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ScheduledMedia struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EnqueueType ForcedTicketEnqueueType `protobuf:"varint,2,opt,name=enqueue_type,json=enqueueType,proto3,enum=jungletv.ForcedTicketEnqueueType" json:"enqueue_type,omitempty"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// interrupt is set when the currently playing entry, unless unskippable, is skipped so the slot starts on time
+	Interrupt bool          `protobuf:"varint,3,opt,name=interrupt,proto3" json:"interrupt,omitempty"`
+	Entries   []*QueueEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *ForciblyEnqueueTicketRequest) Reset() {
-	*x = ForciblyEnqueueTicketRequest{}
+func (x *ScheduledMedia) Reset() {
+	*x = ScheduledMedia{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[28]
+		mi := &file_jungletv_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForciblyEnqueueTicketRequest) String() string {
+func (x *ScheduledMedia) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForciblyEnqueueTicketRequest) ProtoMessage() {}
+func (*ScheduledMedia) ProtoMessage() {}
 
-func (x *ForciblyEnqueueTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[28]
+func (x *ScheduledMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,90 +2990,69 @@ func (x *ForciblyEnqueueTicketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForciblyEnqueueTicketRequest.ProtoReflect.Descriptor instead.
-func (*ForciblyEnqueueTicketRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ScheduledMedia.ProtoReflect.Descriptor instead.
+func (*ScheduledMedia) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ForciblyEnqueueTicketRequest) GetId() string {
+func (x *ScheduledMedia) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ForciblyEnqueueTicketRequest) GetEnqueueType() ForcedTicketEnqueueType {
+func (x *ScheduledMedia) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EnqueueType
+		return x.StartsAt
 	}
-	return ForcedTicketEnqueueType_ENQUEUE
-}
-
-type ForciblyEnqueueTicketResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return nil
 }
 
-func (x *ForciblyEnqueueTicketResponse) Reset() {
-	*x = ForciblyEnqueueTicketResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ScheduledMedia) GetInterrupt() bool {
+	if x != nil {
+		return x.Interrupt
 	}
+	return false
 }
 
-func (x *ForciblyEnqueueTicketResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ForciblyEnqueueTicketResponse) ProtoMessage() {}
-
-func (x *ForciblyEnqueueTicketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScheduledMedia) GetEntries() []*QueueEntry {
+	if x != nil {
+		return x.Entries
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ForciblyEnqueueTicketResponse.ProtoReflect.Descriptor instead.
-func (*ForciblyEnqueueTicketResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{29}
+	return nil
 }
 
-type SubmitActivityChallengeRequest struct {
+type QueueYouTubeVideoData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Challenge       string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	CaptchaResponse string `protobuf:"bytes,2,opt,name=captcha_response,json=captchaResponse,proto3" json:"captcha_response,omitempty"`
-	Trusted         bool   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Id           string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ThumbnailUrl string               `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ChannelTitle string               `protobuf:"bytes,4,opt,name=channel_title,json=channelTitle,proto3" json:"channel_title,omitempty"`
+	StartOffset  *durationpb.Duration `protobuf:"bytes,5,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset    *durationpb.Duration `protobuf:"bytes,6,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
 }
 
-func (x *SubmitActivityChallengeRequest) Reset() {
-	*x = SubmitActivityChallengeRequest{}
+func (x *QueueYouTubeVideoData) Reset() {
+	*x = QueueYouTubeVideoData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[30]
+		mi := &file_jungletv_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitActivityChallengeRequest) String() string {
+func (x *QueueYouTubeVideoData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitActivityChallengeRequest) ProtoMessage() {}
+func (*QueueYouTubeVideoData) ProtoMessage() {}
 
-func (x *SubmitActivityChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[30]
+func (x *QueueYouTubeVideoData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2151,55 +3063,88 @@ func (x *SubmitActivityChallengeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitActivityChallengeRequest.ProtoReflect.Descriptor instead.
-func (*SubmitActivityChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use QueueYouTubeVideoData.ProtoReflect.Descriptor instead.
+func (*QueueYouTubeVideoData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubmitActivityChallengeRequest) GetChallenge() string {
+func (x *QueueYouTubeVideoData) GetId() string {
 	if x != nil {
-		return x.Challenge
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SubmitActivityChallengeRequest) GetCaptchaResponse() string {
+func (x *QueueYouTubeVideoData) GetTitle() string {
 	if x != nil {
-		return x.CaptchaResponse
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SubmitActivityChallengeRequest) GetTrusted() bool {
+func (x *QueueYouTubeVideoData) GetThumbnailUrl() string {
 	if x != nil {
-		return x.Trusted
+		return x.ThumbnailUrl
 	}
-	return false
+	return ""
 }
 
-type SubmitActivityChallengeResponse struct {
+func (x *QueueYouTubeVideoData) GetChannelTitle() string {
+	if x != nil {
+		return x.ChannelTitle
+	}
+	return ""
+}
+
+func (x *QueueYouTubeVideoData) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *QueueYouTubeVideoData) GetEndOffset() *durationpb.Duration {
+	if x != nil {
+		return x.EndOffset
+	}
+	return nil
+}
+
+type QueueYouTubePlaylistData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ChannelTitle string `protobuf:"bytes,4,opt,name=channel_title,json=channelTitle,proto3" json:"channel_title,omitempty"`
+	// items are the videos that will be enqueued, in order
+	Items []*QueueYouTubeVideoData `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// skipped_items are the videos in the playlist that can't be enqueued
+	SkippedItems []*SkippedPlaylistItem `protobuf:"bytes,6,rep,name=skipped_items,json=skippedItems,proto3" json:"skipped_items,omitempty"`
+	// omitted_item_count is how many videos at the end of the playlist were not considered, as only the first 50
+	// videos of a playlist can be enqueued
+	OmittedItemCount uint32 `protobuf:"varint,7,opt,name=omitted_item_count,json=omittedItemCount,proto3" json:"omitted_item_count,omitempty"`
 }
 
-func (x *SubmitActivityChallengeResponse) Reset() {
-	*x = SubmitActivityChallengeResponse{}
+func (x *QueueYouTubePlaylistData) Reset() {
+	*x = QueueYouTubePlaylistData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[31]
+		mi := &file_jungletv_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitActivityChallengeResponse) String() string {
+func (x *QueueYouTubePlaylistData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitActivityChallengeResponse) ProtoMessage() {}
+func (*QueueYouTubePlaylistData) ProtoMessage() {}
 
-func (x *SubmitActivityChallengeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[31]
+func (x *QueueYouTubePlaylistData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,89 +3155,87 @@ func (x *SubmitActivityChallengeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitActivityChallengeResponse.ProtoReflect.Descriptor instead.
-func (*SubmitActivityChallengeResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use QueueYouTubePlaylistData.ProtoReflect.Descriptor instead.
+func (*QueueYouTubePlaylistData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{35}
 }
 
-type ConsumeChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InitialHistorySize uint32 `protobuf:"varint,1,opt,name=initial_history_size,json=initialHistorySize,proto3" json:"initial_history_size,omitempty"`
+func (x *QueueYouTubePlaylistData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *ConsumeChatRequest) Reset() {
-	*x = ConsumeChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueueYouTubePlaylistData) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
+	return ""
 }
 
-func (x *ConsumeChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueueYouTubePlaylistData) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
 }
 
-func (*ConsumeChatRequest) ProtoMessage() {}
+func (x *QueueYouTubePlaylistData) GetChannelTitle() string {
+	if x != nil {
+		return x.ChannelTitle
+	}
+	return ""
+}
 
-func (x *ConsumeChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *QueueYouTubePlaylistData) GetItems() []*QueueYouTubeVideoData {
+	if x != nil {
+		return x.Items
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ConsumeChatRequest.ProtoReflect.Descriptor instead.
-func (*ConsumeChatRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{32}
+func (x *QueueYouTubePlaylistData) GetSkippedItems() []*SkippedPlaylistItem {
+	if x != nil {
+		return x.SkippedItems
+	}
+	return nil
 }
 
-func (x *ConsumeChatRequest) GetInitialHistorySize() uint32 {
+func (x *QueueYouTubePlaylistData) GetOmittedItemCount() uint32 {
 	if x != nil {
-		return x.InitialHistorySize
+		return x.OmittedItemCount
 	}
 	return 0
 }
 
-type ChatUpdate struct {
+type SkippedPlaylistItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//	*ChatUpdate_Disabled
-	//	*ChatUpdate_Enabled
-	//	*ChatUpdate_MessageCreated
-	//	*ChatUpdate_MessageDeleted
-	//	*ChatUpdate_Heartbeat
-	Event isChatUpdate_Event `protobuf_oneof:"event"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ChatUpdate) Reset() {
-	*x = ChatUpdate{}
+func (x *SkippedPlaylistItem) Reset() {
+	*x = SkippedPlaylistItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[33]
+		mi := &file_jungletv_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatUpdate) String() string {
+func (x *SkippedPlaylistItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatUpdate) ProtoMessage() {}
+func (*SkippedPlaylistItem) ProtoMessage() {}
 
-func (x *ChatUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[33]
+func (x *SkippedPlaylistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2303,118 +3246,136 @@ func (x *ChatUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatUpdate.ProtoReflect.Descriptor instead.
-func (*ChatUpdate) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SkippedPlaylistItem.ProtoReflect.Descriptor instead.
+func (*SkippedPlaylistItem) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{36}
 }
 
-func (m *ChatUpdate) GetEvent() isChatUpdate_Event {
-	if m != nil {
-		return m.Event
+func (x *SkippedPlaylistItem) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatUpdate) GetDisabled() *ChatDisabledEvent {
-	if x, ok := x.GetEvent().(*ChatUpdate_Disabled); ok {
-		return x.Disabled
+func (x *SkippedPlaylistItem) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatUpdate) GetEnabled() *ChatEnabledEvent {
-	if x, ok := x.GetEvent().(*ChatUpdate_Enabled); ok {
-		return x.Enabled
+func (x *SkippedPlaylistItem) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatUpdate) GetMessageCreated() *ChatMessageCreatedEvent {
-	if x, ok := x.GetEvent().(*ChatUpdate_MessageCreated); ok {
-		return x.MessageCreated
-	}
-	return nil
-}
+type QueueDirectMediaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ChatUpdate) GetMessageDeleted() *ChatMessageDeletedEvent {
-	if x, ok := x.GetEvent().(*ChatUpdate_MessageDeleted); ok {
-		return x.MessageDeleted
-	}
-	return nil
+	Url       string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AudioOnly bool   `protobuf:"varint,3,opt,name=audio_only,json=audioOnly,proto3" json:"audio_only,omitempty"`
 }
 
-func (x *ChatUpdate) GetHeartbeat() *ChatHeartbeatEvent {
-	if x, ok := x.GetEvent().(*ChatUpdate_Heartbeat); ok {
-		return x.Heartbeat
+func (x *QueueDirectMediaData) Reset() {
+	*x = QueueDirectMediaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isChatUpdate_Event interface {
-	isChatUpdate_Event()
+func (x *QueueDirectMediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ChatUpdate_Disabled struct {
-	Disabled *ChatDisabledEvent `protobuf:"bytes,1,opt,name=disabled,proto3,oneof"`
-}
+func (*QueueDirectMediaData) ProtoMessage() {}
 
-type ChatUpdate_Enabled struct {
-	Enabled *ChatEnabledEvent `protobuf:"bytes,2,opt,name=enabled,proto3,oneof"`
+func (x *QueueDirectMediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ChatUpdate_MessageCreated struct {
-	MessageCreated *ChatMessageCreatedEvent `protobuf:"bytes,3,opt,name=message_created,json=messageCreated,proto3,oneof"`
+// Deprecated: Use QueueDirectMediaData.ProtoReflect.Descriptor instead.
+func (*QueueDirectMediaData) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{37}
 }
 
-type ChatUpdate_MessageDeleted struct {
-	MessageDeleted *ChatMessageDeletedEvent `protobuf:"bytes,4,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+func (x *QueueDirectMediaData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-type ChatUpdate_Heartbeat struct {
-	Heartbeat *ChatHeartbeatEvent `protobuf:"bytes,5,opt,name=heartbeat,proto3,oneof"`
+func (x *QueueDirectMediaData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (*ChatUpdate_Disabled) isChatUpdate_Event() {}
-
-func (*ChatUpdate_Enabled) isChatUpdate_Event() {}
-
-func (*ChatUpdate_MessageCreated) isChatUpdate_Event() {}
-
-func (*ChatUpdate_MessageDeleted) isChatUpdate_Event() {}
-
-func (*ChatUpdate_Heartbeat) isChatUpdate_Event() {}
+func (x *QueueDirectMediaData) GetAudioOnly() bool {
+	if x != nil {
+		return x.AudioOnly
+	}
+	return false
+}
 
-type ChatMessage struct {
+type QueueEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Types that are assignable to Message:
-	//	*ChatMessage_UserMessage
-	//	*ChatMessage_SystemMessage
-	Message   isChatMessage_Message `protobuf_oneof:"message"`
-	Reference *ChatMessage          `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// requested_by is who the entry is credited to, which is not set for anonymous entries
+	RequestedBy *User                `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestCost string               `protobuf:"bytes,3,opt,name=request_cost,json=requestCost,proto3" json:"request_cost,omitempty"`
+	Length      *durationpb.Duration `protobuf:"bytes,4,opt,name=length,proto3" json:"length,omitempty"`
+	Unskippable bool                 `protobuf:"varint,5,opt,name=unskippable,proto3" json:"unskippable,omitempty"`
+	// Types that are assignable to MediaInfo:
+	//	*QueueEntry_YoutubeVideoData
+	//	*QueueEntry_DirectMediaData
+	MediaInfo isQueueEntry_MediaInfo `protobuf_oneof:"media_info"`
+	Pinned    bool                   `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// projected_start assumes entries play to the end unless interrupted by scheduled media and, while playback
+	// is paused, that it resumes immediately
+	ProjectedStart *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=projected_start,json=projectedStart,proto3" json:"projected_start,omitempty"`
+	// contributions is only set for crowdfunded entries. The amounts are each contributor's share of the request cost.
+	// The contributors of anonymous entries are not set
+	Contributions []*QueueEntryContribution `protobuf:"bytes,10,rep,name=contributions,proto3" json:"contributions,omitempty"`
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[34]
+		mi := &file_jungletv_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatMessage) String() string {
+func (x *QueueEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*QueueEntry) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[34]
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,95 +3386,130 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ChatMessage) GetId() int64 {
+func (x *QueueEntry) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ChatMessage) GetCreatedAt() *timestamppb.Timestamp {
+func (x *QueueEntry) GetRequestedBy() *User {
 	if x != nil {
-		return x.CreatedAt
+		return x.RequestedBy
 	}
 	return nil
 }
 
-func (m *ChatMessage) GetMessage() isChatMessage_Message {
-	if m != nil {
-		return m.Message
-	}
+func (x *QueueEntry) GetRequestCost() string {
+	if x != nil {
+		return x.RequestCost
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetLength() *durationpb.Duration {
+	if x != nil {
+		return x.Length
+	}
 	return nil
 }
 
-func (x *ChatMessage) GetUserMessage() *UserChatMessage {
-	if x, ok := x.GetMessage().(*ChatMessage_UserMessage); ok {
-		return x.UserMessage
+func (x *QueueEntry) GetUnskippable() bool {
+	if x != nil {
+		return x.Unskippable
+	}
+	return false
+}
+
+func (m *QueueEntry) GetMediaInfo() isQueueEntry_MediaInfo {
+	if m != nil {
+		return m.MediaInfo
 	}
 	return nil
 }
 
-func (x *ChatMessage) GetSystemMessage() *SystemChatMessage {
-	if x, ok := x.GetMessage().(*ChatMessage_SystemMessage); ok {
-		return x.SystemMessage
+func (x *QueueEntry) GetYoutubeVideoData() *QueueYouTubeVideoData {
+	if x, ok := x.GetMediaInfo().(*QueueEntry_YoutubeVideoData); ok {
+		return x.YoutubeVideoData
 	}
 	return nil
 }
 
-func (x *ChatMessage) GetReference() *ChatMessage {
+func (x *QueueEntry) GetDirectMediaData() *QueueDirectMediaData {
+	if x, ok := x.GetMediaInfo().(*QueueEntry_DirectMediaData); ok {
+		return x.DirectMediaData
+	}
+	return nil
+}
+
+func (x *QueueEntry) GetPinned() bool {
 	if x != nil {
-		return x.Reference
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *QueueEntry) GetProjectedStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedStart
 	}
 	return nil
 }
 
-type isChatMessage_Message interface {
-	isChatMessage_Message()
+func (x *QueueEntry) GetContributions() []*QueueEntryContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
 }
 
-type ChatMessage_UserMessage struct {
-	UserMessage *UserChatMessage `protobuf:"bytes,3,opt,name=user_message,json=userMessage,proto3,oneof"`
+type isQueueEntry_MediaInfo interface {
+	isQueueEntry_MediaInfo()
 }
 
-type ChatMessage_SystemMessage struct {
-	SystemMessage *SystemChatMessage `protobuf:"bytes,4,opt,name=system_message,json=systemMessage,proto3,oneof"`
+type QueueEntry_YoutubeVideoData struct {
+	YoutubeVideoData *QueueYouTubeVideoData `protobuf:"bytes,6,opt,name=youtube_video_data,json=youtubeVideoData,proto3,oneof"`
 }
 
-func (*ChatMessage_UserMessage) isChatMessage_Message() {}
+type QueueEntry_DirectMediaData struct {
+	DirectMediaData *QueueDirectMediaData `protobuf:"bytes,7,opt,name=direct_media_data,json=directMediaData,proto3,oneof"`
+}
 
-func (*ChatMessage_SystemMessage) isChatMessage_Message() {}
+func (*QueueEntry_YoutubeVideoData) isQueueEntry_MediaInfo() {}
 
-type UserChatMessage struct {
+func (*QueueEntry_DirectMediaData) isQueueEntry_MediaInfo() {}
+
+type QueueEntryContribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Author  *User  `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Contributor *User  `protobuf:"bytes,1,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *UserChatMessage) Reset() {
-	*x = UserChatMessage{}
+func (x *QueueEntryContribution) Reset() {
+	*x = QueueEntryContribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[35]
+		mi := &file_jungletv_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserChatMessage) String() string {
+func (x *QueueEntryContribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserChatMessage) ProtoMessage() {}
+func (*QueueEntryContribution) ProtoMessage() {}
 
-func (x *UserChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[35]
+func (x *QueueEntryContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2524,50 +3520,51 @@ func (x *UserChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserChatMessage.ProtoReflect.Descriptor instead.
-func (*UserChatMessage) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use QueueEntryContribution.ProtoReflect.Descriptor instead.
+func (*QueueEntryContribution) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UserChatMessage) GetAuthor() *User {
+func (x *QueueEntryContribution) GetContributor() *User {
 	if x != nil {
-		return x.Author
+		return x.Contributor
 	}
 	return nil
 }
 
-func (x *UserChatMessage) GetContent() string {
+func (x *QueueEntryContribution) GetAmount() string {
 	if x != nil {
-		return x.Content
+		return x.Amount
 	}
 	return ""
 }
 
-type SystemChatMessage struct {
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Roles   []UserRole `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=jungletv.UserRole" json:"roles,omitempty"`
 }
 
-func (x *SystemChatMessage) Reset() {
-	*x = SystemChatMessage{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[36]
+		mi := &file_jungletv_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemChatMessage) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemChatMessage) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *SystemChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[36]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,43 +3575,48 @@ func (x *SystemChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemChatMessage.ProtoReflect.Descriptor instead.
-func (*SystemChatMessage) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SystemChatMessage) GetContent() string {
+func (x *User) GetAddress() string {
 	if x != nil {
-		return x.Content
+		return x.Address
 	}
 	return ""
 }
 
-type ChatDisabledEvent struct {
+func (x *User) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type RewardInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Reason ChatDisabledReason `protobuf:"varint,1,opt,name=reason,proto3,enum=jungletv.ChatDisabledReason" json:"reason,omitempty"`
 }
 
-func (x *ChatDisabledEvent) Reset() {
-	*x = ChatDisabledEvent{}
+func (x *RewardInfoRequest) Reset() {
+	*x = RewardInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[37]
+		mi := &file_jungletv_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatDisabledEvent) String() string {
+func (x *RewardInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatDisabledEvent) ProtoMessage() {}
+func (*RewardInfoRequest) ProtoMessage() {}
 
-func (x *ChatDisabledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[37]
+func (x *RewardInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2625,41 +3627,36 @@ func (x *ChatDisabledEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatDisabledEvent.ProtoReflect.Descriptor instead.
-func (*ChatDisabledEvent) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *ChatDisabledEvent) GetReason() ChatDisabledReason {
-	if x != nil {
-		return x.Reason
-	}
-	return ChatDisabledReason_UNSPECIFIED
+// Deprecated: Use RewardInfoRequest.ProtoReflect.Descriptor instead.
+func (*RewardInfoRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{41}
 }
 
-type ChatEnabledEvent struct {
+type RewardInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RewardAddress string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 }
 
-func (x *ChatEnabledEvent) Reset() {
-	*x = ChatEnabledEvent{}
+func (x *RewardInfoResponse) Reset() {
+	*x = RewardInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[38]
+		mi := &file_jungletv_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatEnabledEvent) String() string {
+func (x *RewardInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatEnabledEvent) ProtoMessage() {}
+func (*RewardInfoResponse) ProtoMessage() {}
 
-func (x *ChatEnabledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[38]
+func (x *RewardInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,36 +3667,44 @@ func (x *ChatEnabledEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatEnabledEvent.ProtoReflect.Descriptor instead.
-func (*ChatEnabledEvent) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RewardInfoResponse.ProtoReflect.Descriptor instead.
+func (*RewardInfoResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{42}
 }
 
-type ChatMessageCreatedEvent struct {
+func (x *RewardInfoResponse) GetRewardAddress() string {
+	if x != nil {
+		return x.RewardAddress
+	}
+	return ""
+}
+
+type RewardHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *ChatMessageCreatedEvent) Reset() {
-	*x = ChatMessageCreatedEvent{}
+func (x *RewardHistoryRequest) Reset() {
+	*x = RewardHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[39]
+		mi := &file_jungletv_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatMessageCreatedEvent) String() string {
+func (x *RewardHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessageCreatedEvent) ProtoMessage() {}
+func (*RewardHistoryRequest) ProtoMessage() {}
 
-func (x *ChatMessageCreatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[39]
+func (x *RewardHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,43 +3715,57 @@ func (x *ChatMessageCreatedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessageCreatedEvent.ProtoReflect.Descriptor instead.
-func (*ChatMessageCreatedEvent) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RewardHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ChatMessageCreatedEvent) GetMessage() *ChatMessage {
+func (x *RewardHistoryRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.Message
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type ChatMessageDeletedEvent struct {
+func (x *RewardHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReceivedReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DistributionId string                 `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Entry          *QueueEntry            `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	DistributedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=distributed_at,json=distributedAt,proto3" json:"distributed_at,omitempty"`
+	Amount         string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// block_hash is empty if the distribution failed
+	BlockHash string                   `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Status    RewardDistributionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=jungletv.RewardDistributionStatus" json:"status,omitempty"`
 }
 
-func (x *ChatMessageDeletedEvent) Reset() {
-	*x = ChatMessageDeletedEvent{}
+func (x *ReceivedReward) Reset() {
+	*x = ReceivedReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[40]
+		mi := &file_jungletv_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatMessageDeletedEvent) String() string {
+func (x *ReceivedReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessageDeletedEvent) ProtoMessage() {}
+func (*ReceivedReward) ProtoMessage() {}
 
-func (x *ChatMessageDeletedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[40]
+func (x *ReceivedReward) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2757,43 +3776,88 @@ func (x *ChatMessageDeletedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessageDeletedEvent.ProtoReflect.Descriptor instead.
-func (*ChatMessageDeletedEvent) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReceivedReward.ProtoReflect.Descriptor instead.
+func (*ReceivedReward) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ChatMessageDeletedEvent) GetId() int64 {
+func (x *ReceivedReward) GetDistributionId() string {
 	if x != nil {
-		return x.Id
+		return x.DistributionId
 	}
-	return 0
+	return ""
 }
 
-type ChatHeartbeatEvent struct {
+func (x *ReceivedReward) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReceivedReward) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ReceivedReward) GetDistributedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DistributedAt
+	}
+	return nil
+}
+
+func (x *ReceivedReward) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReceivedReward) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ReceivedReward) GetStatus() RewardDistributionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RewardDistributionStatus_DISTRIBUTED
+}
+
+type RewardHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sequence uint32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Rewards []*ReceivedReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Total   uint32            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// total_received is the sum of the rewards successfully sent to the user across all distributions
+	TotalReceived string `protobuf:"bytes,3,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
 }
 
-func (x *ChatHeartbeatEvent) Reset() {
-	*x = ChatHeartbeatEvent{}
+func (x *RewardHistoryResponse) Reset() {
+	*x = RewardHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[41]
+		mi := &file_jungletv_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatHeartbeatEvent) String() string {
+func (x *RewardHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatHeartbeatEvent) ProtoMessage() {}
+func (*RewardHistoryResponse) ProtoMessage() {}
 
-func (x *ChatHeartbeatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[41]
+func (x *RewardHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,45 +3868,60 @@ func (x *ChatHeartbeatEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatHeartbeatEvent.ProtoReflect.Descriptor instead.
-func (*ChatHeartbeatEvent) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RewardHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ChatHeartbeatEvent) GetSequence() uint32 {
+func (x *RewardHistoryResponse) GetRewards() []*ReceivedReward {
 	if x != nil {
-		return x.Sequence
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *RewardHistoryResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type SendChatMessageRequest struct {
+func (x *RewardHistoryResponse) GetTotalReceived() string {
+	if x != nil {
+		return x.TotalReceived
+	}
+	return ""
+}
+
+type RewardDistributionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content          string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Trusted          bool   `protobuf:"varint,2,opt,name=trusted,proto3" json:"trusted,omitempty"`
-	ReplyReferenceId *int64 `protobuf:"varint,3,opt,name=reply_reference_id,json=replyReferenceId,proto3,oneof" json:"reply_reference_id,omitempty"`
+	// if address is set, only distributions in which the address was a recipient are returned
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Offset  uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SendChatMessageRequest) Reset() {
-	*x = SendChatMessageRequest{}
+func (x *RewardDistributionsRequest) Reset() {
+	*x = RewardDistributionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[42]
+		mi := &file_jungletv_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendChatMessageRequest) String() string {
+func (x *RewardDistributionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageRequest) ProtoMessage() {}
+func (*RewardDistributionsRequest) ProtoMessage() {}
 
-func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[42]
+func (x *RewardDistributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,57 +3932,59 @@ func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use RewardDistributionsRequest.ProtoReflect.Descriptor instead.
+func (*RewardDistributionsRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SendChatMessageRequest) GetContent() string {
+func (x *RewardDistributionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Content
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SendChatMessageRequest) GetTrusted() bool {
+func (x *RewardDistributionsRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.Trusted
+		return x.Offset
 	}
-	return false
+	return 0
 }
 
-func (x *SendChatMessageRequest) GetReplyReferenceId() int64 {
-	if x != nil && x.ReplyReferenceId != nil {
-		return *x.ReplyReferenceId
+func (x *RewardDistributionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type SendChatMessageResponse struct {
+type RewardRecipient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (x *SendChatMessageResponse) Reset() {
-	*x = SendChatMessageResponse{}
+func (x *RewardRecipient) Reset() {
+	*x = RewardRecipient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[43]
+		mi := &file_jungletv_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendChatMessageResponse) String() string {
+func (x *RewardRecipient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageResponse) ProtoMessage() {}
+func (*RewardRecipient) ProtoMessage() {}
 
-func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[43]
+func (x *RewardRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,43 +3995,66 @@ func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use RewardRecipient.ProtoReflect.Descriptor instead.
+func (*RewardRecipient) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SendChatMessageResponse) GetId() int64 {
+func (x *RewardRecipient) GetAddress() string {
 	if x != nil {
-		return x.Id
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type RemoveChatMessageRequest struct {
+func (x *RewardRecipient) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardRecipient) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type RewardDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Entry         *QueueEntry            `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	DistributedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=distributed_at,json=distributedAt,proto3" json:"distributed_at,omitempty"`
+	// budget includes what was carried over from previous distributions
+	Budget             string                   `protobuf:"bytes,5,opt,name=budget,proto3" json:"budget,omitempty"`
+	EligibleSpectators uint32                   `protobuf:"varint,6,opt,name=eligible_spectators,json=eligibleSpectators,proto3" json:"eligible_spectators,omitempty"`
+	AmountForEach      string                   `protobuf:"bytes,7,opt,name=amount_for_each,json=amountForEach,proto3" json:"amount_for_each,omitempty"`
+	Status             RewardDistributionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=jungletv.RewardDistributionStatus" json:"status,omitempty"`
+	Recipients         []*RewardRecipient       `protobuf:"bytes,9,rep,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
-func (x *RemoveChatMessageRequest) Reset() {
-	*x = RemoveChatMessageRequest{}
+func (x *RewardDistribution) Reset() {
+	*x = RewardDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[44]
+		mi := &file_jungletv_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveChatMessageRequest) String() string {
+func (x *RewardDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveChatMessageRequest) ProtoMessage() {}
+func (*RewardDistribution) ProtoMessage() {}
 
-func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[44]
+func (x *RewardDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,41 +4065,100 @@ func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveChatMessageRequest.ProtoReflect.Descriptor instead.
-func (*RemoveChatMessageRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RewardDistribution.ProtoReflect.Descriptor instead.
+func (*RewardDistribution) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RemoveChatMessageRequest) GetId() int64 {
+func (x *RewardDistribution) GetId() string {
 	if x != nil {
 		return x.Id
 	}
+	return ""
+}
+
+func (x *RewardDistribution) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RewardDistribution) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *RewardDistribution) GetDistributedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DistributedAt
+	}
+	return nil
+}
+
+func (x *RewardDistribution) GetBudget() string {
+	if x != nil {
+		return x.Budget
+	}
+	return ""
+}
+
+func (x *RewardDistribution) GetEligibleSpectators() uint32 {
+	if x != nil {
+		return x.EligibleSpectators
+	}
 	return 0
 }
 
-type RemoveChatMessageResponse struct {
+func (x *RewardDistribution) GetAmountForEach() string {
+	if x != nil {
+		return x.AmountForEach
+	}
+	return ""
+}
+
+func (x *RewardDistribution) GetStatus() RewardDistributionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RewardDistributionStatus_DISTRIBUTED
+}
+
+func (x *RewardDistribution) GetRecipients() []*RewardRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type RewardDistributionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Distributions []*RewardDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	Total         uint32                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *RemoveChatMessageResponse) Reset() {
-	*x = RemoveChatMessageResponse{}
+func (x *RewardDistributionsResponse) Reset() {
+	*x = RewardDistributionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[45]
+		mi := &file_jungletv_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveChatMessageResponse) String() string {
+func (x *RewardDistributionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveChatMessageResponse) ProtoMessage() {}
+func (*RewardDistributionsResponse) ProtoMessage() {}
 
-func (x *RemoveChatMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[45]
+func (x *RewardDistributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,37 +4169,51 @@ func (x *RemoveChatMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveChatMessageResponse.ProtoReflect.Descriptor instead.
-func (*RemoveChatMessageResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RewardDistributionsResponse.ProtoReflect.Descriptor instead.
+func (*RewardDistributionsResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{49}
 }
 
-type SetChatSettingsRequest struct {
+func (x *RewardDistributionsResponse) GetDistributions() []*RewardDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *RewardDistributionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RemoveQueueEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled  bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Slowmode bool `protobuf:"varint,2,opt,name=slowmode,proto3" json:"slowmode,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *SetChatSettingsRequest) Reset() {
-	*x = SetChatSettingsRequest{}
+func (x *RemoveQueueEntryRequest) Reset() {
+	*x = RemoveQueueEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[46]
+		mi := &file_jungletv_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetChatSettingsRequest) String() string {
+func (x *RemoveQueueEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetChatSettingsRequest) ProtoMessage() {}
+func (*RemoveQueueEntryRequest) ProtoMessage() {}
 
-func (x *SetChatSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[46]
+func (x *RemoveQueueEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3047,48 +4224,48 @@ func (x *SetChatSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetChatSettingsRequest.ProtoReflect.Descriptor instead.
-func (*SetChatSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RemoveQueueEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveQueueEntryRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SetChatSettingsRequest) GetEnabled() bool {
+func (x *RemoveQueueEntryRequest) GetId() string {
 	if x != nil {
-		return x.Enabled
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *SetChatSettingsRequest) GetSlowmode() bool {
+func (x *RemoveQueueEntryRequest) GetChannelId() string {
 	if x != nil {
-		return x.Slowmode
+		return x.ChannelId
 	}
-	return false
+	return ""
 }
 
-type SetChatSettingsResponse struct {
+type RemoveQueueEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetChatSettingsResponse) Reset() {
-	*x = SetChatSettingsResponse{}
+func (x *RemoveQueueEntryResponse) Reset() {
+	*x = RemoveQueueEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[47]
+		mi := &file_jungletv_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetChatSettingsResponse) String() string {
+func (x *RemoveQueueEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetChatSettingsResponse) ProtoMessage() {}
+func (*RemoveQueueEntryResponse) ProtoMessage() {}
 
-func (x *SetChatSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[47]
+func (x *RemoveQueueEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3099,41 +4276,44 @@ func (x *SetChatSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetChatSettingsResponse.ProtoReflect.Descriptor instead.
-func (*SetChatSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use RemoveQueueEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveQueueEntryResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{51}
 }
 
-type BanUserRequest struct {
+type MoveQueueEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	RemoteAddress   string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
-	ChatBanned      bool   `protobuf:"varint,3,opt,name=chat_banned,json=chatBanned,proto3" json:"chat_banned,omitempty"`
-	EnqueuingBanned bool   `protobuf:"varint,4,opt,name=enqueuing_banned,json=enqueuingBanned,proto3" json:"enqueuing_banned,omitempty"`
-	RewardsBanned   bool   `protobuf:"varint,5,opt,name=rewards_banned,json=rewardsBanned,proto3" json:"rewards_banned,omitempty"`
-	Reason          string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Movement QueueEntryMovement `protobuf:"varint,2,opt,name=movement,proto3,enum=jungletv.QueueEntryMovement" json:"movement,omitempty"`
+	// position is zero-based and only used with MOVE_TO_POSITION. Position 0 is the currently playing entry
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// swap_with_id is only used with SWAP
+	SwapWithId string `protobuf:"bytes,4,opt,name=swap_with_id,json=swapWithId,proto3" json:"swap_with_id,omitempty"`
+	// pin keeps the entry at its new position when entries are added in front of it. Not allowed with SWAP
+	Pin       bool   `protobuf:"varint,5,opt,name=pin,proto3" json:"pin,omitempty"`
+	ChannelId string `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *BanUserRequest) Reset() {
-	*x = BanUserRequest{}
+func (x *MoveQueueEntryRequest) Reset() {
+	*x = MoveQueueEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[48]
+		mi := &file_jungletv_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BanUserRequest) String() string {
+func (x *MoveQueueEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserRequest) ProtoMessage() {}
+func (*MoveQueueEntryRequest) ProtoMessage() {}
 
-func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[48]
+func (x *MoveQueueEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,78 +4324,80 @@ func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
-func (*BanUserRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use MoveQueueEntryRequest.ProtoReflect.Descriptor instead.
+func (*MoveQueueEntryRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *BanUserRequest) GetAddress() string {
+func (x *MoveQueueEntryRequest) GetId() string {
 	if x != nil {
-		return x.Address
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BanUserRequest) GetRemoteAddress() string {
+func (x *MoveQueueEntryRequest) GetMovement() QueueEntryMovement {
 	if x != nil {
-		return x.RemoteAddress
+		return x.Movement
 	}
-	return ""
+	return QueueEntryMovement_MOVE_UP
 }
 
-func (x *BanUserRequest) GetChatBanned() bool {
+func (x *MoveQueueEntryRequest) GetPosition() uint32 {
 	if x != nil {
-		return x.ChatBanned
+		return x.Position
 	}
-	return false
+	return 0
 }
 
-func (x *BanUserRequest) GetEnqueuingBanned() bool {
+func (x *MoveQueueEntryRequest) GetSwapWithId() string {
 	if x != nil {
-		return x.EnqueuingBanned
+		return x.SwapWithId
 	}
-	return false
+	return ""
 }
 
-func (x *BanUserRequest) GetRewardsBanned() bool {
+func (x *MoveQueueEntryRequest) GetPin() bool {
 	if x != nil {
-		return x.RewardsBanned
+		return x.Pin
 	}
 	return false
 }
 
-func (x *BanUserRequest) GetReason() string {
+func (x *MoveQueueEntryRequest) GetChannelId() string {
 	if x != nil {
-		return x.Reason
+		return x.ChannelId
 	}
 	return ""
 }
 
-type BanUserResponse struct {
+type MoveQueueEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BanIds []string `protobuf:"bytes,1,rep,name=ban_ids,json=banIds,proto3" json:"ban_ids,omitempty"`
+	// with SWAP, the positions are those of the entry with the specified id
+	PreviousPosition uint32 `protobuf:"varint,1,opt,name=previous_position,json=previousPosition,proto3" json:"previous_position,omitempty"`
+	NewPosition      uint32 `protobuf:"varint,2,opt,name=new_position,json=newPosition,proto3" json:"new_position,omitempty"`
 }
 
-func (x *BanUserResponse) Reset() {
-	*x = BanUserResponse{}
+func (x *MoveQueueEntryResponse) Reset() {
+	*x = MoveQueueEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[49]
+		mi := &file_jungletv_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BanUserResponse) String() string {
+func (x *MoveQueueEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserResponse) ProtoMessage() {}
+func (*MoveQueueEntryResponse) ProtoMessage() {}
 
-func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[49]
+func (x *MoveQueueEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,44 +4408,51 @@ func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
-func (*BanUserResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use MoveQueueEntryResponse.ProtoReflect.Descriptor instead.
+func (*MoveQueueEntryResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *BanUserResponse) GetBanIds() []string {
+func (x *MoveQueueEntryResponse) GetPreviousPosition() uint32 {
 	if x != nil {
-		return x.BanIds
+		return x.PreviousPosition
 	}
-	return nil
+	return 0
 }
 
-type RemoveBanRequest struct {
+func (x *MoveQueueEntryResponse) GetNewPosition() uint32 {
+	if x != nil {
+		return x.NewPosition
+	}
+	return 0
+}
+
+type SetPlaybackPausedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BanId  string `protobuf:"bytes,1,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Paused    bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *RemoveBanRequest) Reset() {
-	*x = RemoveBanRequest{}
+func (x *SetPlaybackPausedRequest) Reset() {
+	*x = SetPlaybackPausedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[50]
+		mi := &file_jungletv_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveBanRequest) String() string {
+func (x *SetPlaybackPausedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBanRequest) ProtoMessage() {}
+func (*SetPlaybackPausedRequest) ProtoMessage() {}
 
-func (x *RemoveBanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[50]
+func (x *SetPlaybackPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3274,48 +4463,48 @@ func (x *RemoveBanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBanRequest.ProtoReflect.Descriptor instead.
-func (*RemoveBanRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SetPlaybackPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetPlaybackPausedRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *RemoveBanRequest) GetBanId() string {
+func (x *SetPlaybackPausedRequest) GetPaused() bool {
 	if x != nil {
-		return x.BanId
+		return x.Paused
 	}
-	return ""
+	return false
 }
 
-func (x *RemoveBanRequest) GetReason() string {
+func (x *SetPlaybackPausedRequest) GetChannelId() string {
 	if x != nil {
-		return x.Reason
+		return x.ChannelId
 	}
 	return ""
 }
 
-type RemoveBanResponse struct {
+type SetPlaybackPausedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveBanResponse) Reset() {
-	*x = RemoveBanResponse{}
+func (x *SetPlaybackPausedResponse) Reset() {
+	*x = SetPlaybackPausedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[51]
+		mi := &file_jungletv_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveBanResponse) String() string {
+func (x *SetPlaybackPausedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBanResponse) ProtoMessage() {}
+func (*SetPlaybackPausedResponse) ProtoMessage() {}
 
-func (x *RemoveBanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[51]
+func (x *SetPlaybackPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3326,36 +4515,39 @@ func (x *RemoveBanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBanResponse.ProtoReflect.Descriptor instead.
-func (*RemoveBanResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SetPlaybackPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetPlaybackPausedResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{55}
 }
 
-type SetVideoEnqueuingEnabledRequest struct {
+type ScheduleMediaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Allowed AllowedVideoEnqueuingType `protobuf:"varint,1,opt,name=allowed,proto3,enum=jungletv.AllowedVideoEnqueuingType" json:"allowed,omitempty"`
+	// media is validated as if it was being enqueued, and its channel_id selects the channel to schedule it on
+	Media     *EnqueueMediaRequest   `protobuf:"bytes,1,opt,name=media,proto3" json:"media,omitempty"`
+	StartsAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	Interrupt bool                   `protobuf:"varint,3,opt,name=interrupt,proto3" json:"interrupt,omitempty"`
 }
 
-func (x *SetVideoEnqueuingEnabledRequest) Reset() {
-	*x = SetVideoEnqueuingEnabledRequest{}
+func (x *ScheduleMediaRequest) Reset() {
+	*x = ScheduleMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[52]
+		mi := &file_jungletv_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVideoEnqueuingEnabledRequest) String() string {
+func (x *ScheduleMediaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVideoEnqueuingEnabledRequest) ProtoMessage() {}
+func (*ScheduleMediaRequest) ProtoMessage() {}
 
-func (x *SetVideoEnqueuingEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[52]
+func (x *ScheduleMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,41 +4558,57 @@ func (x *SetVideoEnqueuingEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVideoEnqueuingEnabledRequest.ProtoReflect.Descriptor instead.
-func (*SetVideoEnqueuingEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ScheduleMediaRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMediaRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SetVideoEnqueuingEnabledRequest) GetAllowed() AllowedVideoEnqueuingType {
+func (x *ScheduleMediaRequest) GetMedia() *EnqueueMediaRequest {
 	if x != nil {
-		return x.Allowed
+		return x.Media
 	}
-	return AllowedVideoEnqueuingType_DISABLED
+	return nil
 }
 
-type SetVideoEnqueuingEnabledResponse struct {
+func (x *ScheduleMediaRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduleMediaRequest) GetInterrupt() bool {
+	if x != nil {
+		return x.Interrupt
+	}
+	return false
+}
+
+type ScheduleMediaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SetVideoEnqueuingEnabledResponse) Reset() {
-	*x = SetVideoEnqueuingEnabledResponse{}
+func (x *ScheduleMediaResponse) Reset() {
+	*x = ScheduleMediaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[53]
+		mi := &file_jungletv_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVideoEnqueuingEnabledResponse) String() string {
+func (x *ScheduleMediaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVideoEnqueuingEnabledResponse) ProtoMessage() {}
+func (*ScheduleMediaResponse) ProtoMessage() {}
 
-func (x *SetVideoEnqueuingEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[53]
+func (x *ScheduleMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3411,37 +4619,44 @@ func (x *SetVideoEnqueuingEnabledResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVideoEnqueuingEnabledResponse.ProtoReflect.Descriptor instead.
-func (*SetVideoEnqueuingEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ScheduleMediaResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMediaResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{57}
 }
 
-type UserChatMessagesRequest struct {
+func (x *ScheduleMediaResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnscheduleMediaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	NumMessages uint32 `protobuf:"varint,2,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *UserChatMessagesRequest) Reset() {
-	*x = UserChatMessagesRequest{}
+func (x *UnscheduleMediaRequest) Reset() {
+	*x = UnscheduleMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[54]
+		mi := &file_jungletv_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserChatMessagesRequest) String() string {
+func (x *UnscheduleMediaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserChatMessagesRequest) ProtoMessage() {}
+func (*UnscheduleMediaRequest) ProtoMessage() {}
 
-func (x *UserChatMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[54]
+func (x *UnscheduleMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,50 +4667,48 @@ func (x *UserChatMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserChatMessagesRequest.ProtoReflect.Descriptor instead.
-func (*UserChatMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use UnscheduleMediaRequest.ProtoReflect.Descriptor instead.
+func (*UnscheduleMediaRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UserChatMessagesRequest) GetAddress() string {
+func (x *UnscheduleMediaRequest) GetId() string {
 	if x != nil {
-		return x.Address
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UserChatMessagesRequest) GetNumMessages() uint32 {
+func (x *UnscheduleMediaRequest) GetChannelId() string {
 	if x != nil {
-		return x.NumMessages
+		return x.ChannelId
 	}
-	return 0
+	return ""
 }
 
-type UserChatMessagesResponse struct {
+type UnscheduleMediaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *UserChatMessagesResponse) Reset() {
-	*x = UserChatMessagesResponse{}
+func (x *UnscheduleMediaResponse) Reset() {
+	*x = UnscheduleMediaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[55]
+		mi := &file_jungletv_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserChatMessagesResponse) String() string {
+func (x *UnscheduleMediaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserChatMessagesResponse) ProtoMessage() {}
+func (*UnscheduleMediaResponse) ProtoMessage() {}
 
-func (x *UserChatMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[55]
+func (x *UnscheduleMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3506,44 +4719,41 @@ func (x *UserChatMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserChatMessagesResponse.ProtoReflect.Descriptor instead.
-func (*UserChatMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *UserChatMessagesResponse) GetMessages() []*ChatMessage {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
+// Deprecated: Use UnscheduleMediaResponse.ProtoReflect.Descriptor instead.
+func (*UnscheduleMediaResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{59}
 }
 
-type SubmitProofOfWorkRequest struct {
+type AutoEnqueueItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Previous []byte `protobuf:"bytes,1,opt,name=previous,proto3" json:"previous,omitempty"`
-	Work     []byte `protobuf:"bytes,2,opt,name=work,proto3" json:"work,omitempty"`
+	Id     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weight uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Title  string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// unavailable_reason is set when the item could not be enqueued and is being skipped. Ignored in SetAutoEnqueueList
+	UnavailableReason string `protobuf:"bytes,5,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
 }
 
-func (x *SubmitProofOfWorkRequest) Reset() {
-	*x = SubmitProofOfWorkRequest{}
+func (x *AutoEnqueueItem) Reset() {
+	*x = AutoEnqueueItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[56]
+		mi := &file_jungletv_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitProofOfWorkRequest) String() string {
+func (x *AutoEnqueueItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitProofOfWorkRequest) ProtoMessage() {}
+func (*AutoEnqueueItem) ProtoMessage() {}
 
-func (x *SubmitProofOfWorkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[56]
+func (x *AutoEnqueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3554,48 +4764,75 @@ func (x *SubmitProofOfWorkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitProofOfWorkRequest.ProtoReflect.Descriptor instead.
-func (*SubmitProofOfWorkRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use AutoEnqueueItem.ProtoReflect.Descriptor instead.
+func (*AutoEnqueueItem) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SubmitProofOfWorkRequest) GetPrevious() []byte {
+func (x *AutoEnqueueItem) GetId() string {
 	if x != nil {
-		return x.Previous
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *SubmitProofOfWorkRequest) GetWork() []byte {
+func (x *AutoEnqueueItem) GetWeight() uint32 {
 	if x != nil {
-		return x.Work
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AutoEnqueueItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type SubmitProofOfWorkResponse struct {
+func (x *AutoEnqueueItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AutoEnqueueItem) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+// AutoEnqueueRotation restricts auto-enqueuing to items with any of the tags, between the start and end times of
+// the day (UTC), represented as the time elapsed since midnight
+type AutoEnqueueRotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Start *durationpb.Duration `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *durationpb.Duration `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Tags  []string             `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *SubmitProofOfWorkResponse) Reset() {
-	*x = SubmitProofOfWorkResponse{}
+func (x *AutoEnqueueRotation) Reset() {
+	*x = AutoEnqueueRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[57]
+		mi := &file_jungletv_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitProofOfWorkResponse) String() string {
+func (x *AutoEnqueueRotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitProofOfWorkResponse) ProtoMessage() {}
+func (*AutoEnqueueRotation) ProtoMessage() {}
 
-func (x *SubmitProofOfWorkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[57]
+func (x *AutoEnqueueRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3606,34 +4843,57 @@ func (x *SubmitProofOfWorkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitProofOfWorkResponse.ProtoReflect.Descriptor instead.
-func (*SubmitProofOfWorkResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use AutoEnqueueRotation.ProtoReflect.Descriptor instead.
+func (*AutoEnqueueRotation) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{61}
 }
 
-type UserPermissionLevelRequest struct {
+func (x *AutoEnqueueRotation) GetStart() *durationpb.Duration {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AutoEnqueueRotation) GetEnd() *durationpb.Duration {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *AutoEnqueueRotation) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AutoEnqueueListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *UserPermissionLevelRequest) Reset() {
-	*x = UserPermissionLevelRequest{}
+func (x *AutoEnqueueListRequest) Reset() {
+	*x = AutoEnqueueListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[58]
+		mi := &file_jungletv_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserPermissionLevelRequest) String() string {
+func (x *AutoEnqueueListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPermissionLevelRequest) ProtoMessage() {}
+func (*AutoEnqueueListRequest) ProtoMessage() {}
 
-func (x *UserPermissionLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[58]
+func (x *AutoEnqueueListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,36 +4904,44 @@ func (x *UserPermissionLevelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPermissionLevelRequest.ProtoReflect.Descriptor instead.
-func (*UserPermissionLevelRequest) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use AutoEnqueueListRequest.ProtoReflect.Descriptor instead.
+func (*AutoEnqueueListRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{62}
 }
 
-type UserPermissionLevelResponse struct {
+func (x *AutoEnqueueListRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type AutoEnqueueListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PermissionLevel PermissionLevel `protobuf:"varint,1,opt,name=permission_level,json=permissionLevel,proto3,enum=jungletv.PermissionLevel" json:"permission_level,omitempty"`
+	Items     []*AutoEnqueueItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Rotations []*AutoEnqueueRotation `protobuf:"bytes,2,rep,name=rotations,proto3" json:"rotations,omitempty"`
 }
 
-func (x *UserPermissionLevelResponse) Reset() {
-	*x = UserPermissionLevelResponse{}
+func (x *AutoEnqueueListResponse) Reset() {
+	*x = AutoEnqueueListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jungletv_proto_msgTypes[59]
+		mi := &file_jungletv_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserPermissionLevelResponse) String() string {
+func (x *AutoEnqueueListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPermissionLevelResponse) ProtoMessage() {}
+func (*AutoEnqueueListResponse) ProtoMessage() {}
 
-func (x *UserPermissionLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jungletv_proto_msgTypes[59]
+func (x *AutoEnqueueListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3684,724 +4952,5699 @@ func (x *UserPermissionLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPermissionLevelResponse.ProtoReflect.Descriptor instead.
-func (*UserPermissionLevelResponse) Descriptor() ([]byte, []int) {
-	return file_jungletv_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use AutoEnqueueListResponse.ProtoReflect.Descriptor instead.
+func (*AutoEnqueueListResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UserPermissionLevelResponse) GetPermissionLevel() PermissionLevel {
+func (x *AutoEnqueueListResponse) GetItems() []*AutoEnqueueItem {
 	if x != nil {
-		return x.PermissionLevel
+		return x.Items
 	}
-	return PermissionLevel_UNAUTHENTICATED
+	return nil
 }
 
-var File_jungletv_proto protoreflect.FileDescriptor
+func (x *AutoEnqueueListResponse) GetRotations() []*AutoEnqueueRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
 
-var file_jungletv_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x0d, 0x53,
-	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
-	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
-	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75,
-	0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x48, 0x00,
-	0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65,
-	0x64, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x4e, 0x0a, 0x23, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f,
-	0x70, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a,
-	0x19, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x45, 0x6e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd2, 0x01, 0x0a, 0x13, 0x45, 0x6e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61,
-	0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x12,
-	0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75,
-	0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79,
-	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x42,
-	0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x9d, 0x01,
-	0x0a, 0x14, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x39,
-	0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00,
-	0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x65, 0x6e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a,
-	0x13, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x82, 0x04, 0x0a, 0x12,
-	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27,
-	0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x77,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73,
-	0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73,
-	0x5f, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x50, 0x6c, 0x61,
-	0x79, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x55, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x4f, 0x0a, 0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f,
-	0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x10, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61,
-	0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x22, 0x33, 0x0a, 0x14, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70,
-	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x50, 0x6f, 0x77, 0x22, 0x14, 0x0a, 0x12, 0x4e, 0x6f,
-	0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x2c, 0x0a, 0x1a, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x59, 0x6f,
-	0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85,
-	0x05, 0x0a, 0x1a, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2d,
-	0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
-	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x12, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x76, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x48, 0x02, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x70,
-	0x6f, 0x77, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66,
-	0x57, 0x6f, 0x72, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x54,
-	0x61, 0x73, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x75, 0x62, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c,
-	0x61, 0x79, 0x69, 0x6e, 0x67, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f,
-	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f,
-	0x77, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x37, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x45, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a,
-	0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
-	0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75,
-	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x23,
-	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75,
-	0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4f, 0x0a,
-	0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62,
-	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79, 0x6f,
-	0x75, 0x74, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0c,
-	0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x4a, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28,
-	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a,
-	0x12, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x74, 0x0a, 0x1c, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x44, 0x0a, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
-	0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45,
-	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x69,
-	0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x70,
-	0x74, 0x63, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x21,
-	0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x0a, 0x43, 0x68,
-	0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
-	0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb5,
-	0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a,
-	0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x53, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x11, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x43, 0x68,
-	0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x68, 0x61,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72,
-	0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e,
-	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b,
-	0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x53,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x6f, 0x77, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x77, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
-	0x61, 0x74, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x63, 0x68, 0x61, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65,
-	0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67,
-	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x49, 0x64,
-	0x73, 0x22, 0x41, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x1f, 0x53, 0x65, 0x74,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x53,
-	0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x56, 0x0a, 0x17, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x77, 0x6f,
-	0x72, 0x6b, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1c, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a,
-	0x1b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x76, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x2a, 0x3d, 0x0a, 0x18, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41,
-	0x49, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
-	0x02, 0x2a, 0x19, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x2a, 0x43, 0x0a, 0x17,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x4e, 0x45, 0x58,
-	0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x4e, 0x4f, 0x57, 0x10,
-	0x02, 0x2a, 0x40, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x4f, 0x44, 0x45,
-	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e,
-	0x54, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x19, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0f, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x13,
-	0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x32, 0x8f, 0x0d, 0x0a, 0x08, 0x4a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x54, 0x56, 0x12, 0x3f, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12,
-	0x17, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1d, 0x2e, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x70, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
-	0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x12, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0f, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x6a,
-	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x46,
-	0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x26, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
-	0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6a,
-	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79,
-	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x6a, 0x75,
-	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
-	0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
-	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73,
-	0x0a, 0x18, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e,
-	0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
-	0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x76, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
-	0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
-	0x10, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6e, 0x79, 0x69, 0x6d, 0x2f, 0x6a,
-	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+type SetAutoEnqueueListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Items     []*AutoEnqueueItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Rotations []*AutoEnqueueRotation `protobuf:"bytes,3,rep,name=rotations,proto3" json:"rotations,omitempty"`
 }
 
-var (
-	file_jungletv_proto_rawDescOnce sync.Once
-	file_jungletv_proto_rawDescData = file_jungletv_proto_rawDesc
-)
+func (x *SetAutoEnqueueListRequest) Reset() {
+	*x = SetAutoEnqueueListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_jungletv_proto_rawDescGZIP() []byte {
-	file_jungletv_proto_rawDescOnce.Do(func() {
-		file_jungletv_proto_rawDescData = protoimpl.X.CompressGZIP(file_jungletv_proto_rawDescData)
-	})
-	return file_jungletv_proto_rawDescData
+func (x *SetAutoEnqueueListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_jungletv_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_jungletv_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
-var file_jungletv_proto_goTypes = []interface{}{
-	(EnqueueMediaTicketStatus)(0),            // 0: jungletv.EnqueueMediaTicketStatus
-	(UserRole)(0),                            // 1: jungletv.UserRole
-	(ForcedTicketEnqueueType)(0),             // 2: jungletv.ForcedTicketEnqueueType
-	(ChatDisabledReason)(0),                  // 3: jungletv.ChatDisabledReason
-	(AllowedVideoEnqueuingType)(0),           // 4: jungletv.AllowedVideoEnqueuingType
-	(PermissionLevel)(0),                     // 5: jungletv.PermissionLevel
-	(*SignInRequest)(nil),                    // 6: jungletv.SignInRequest
-	(*SignInProgress)(nil),                   // 7: jungletv.SignInProgress
-	(*SignInVerification)(nil),               // 8: jungletv.SignInVerification
-	(*SignInAccountUnopened)(nil),            // 9: jungletv.SignInAccountUnopened
-	(*SignInResponse)(nil),                   // 10: jungletv.SignInResponse
-	(*SignInVerificationExpired)(nil),        // 11: jungletv.SignInVerificationExpired
-	(*EnqueueYouTubeVideoData)(nil),          // 12: jungletv.EnqueueYouTubeVideoData
-	(*EnqueueStubData)(nil),                  // 13: jungletv.EnqueueStubData
-	(*EnqueueMediaRequest)(nil),              // 14: jungletv.EnqueueMediaRequest
-	(*EnqueueMediaResponse)(nil),             // 15: jungletv.EnqueueMediaResponse
-	(*EnqueueMediaFailure)(nil),              // 16: jungletv.EnqueueMediaFailure
-	(*EnqueueMediaTicket)(nil),               // 17: jungletv.EnqueueMediaTicket
-	(*MonitorTicketRequest)(nil),             // 18: jungletv.MonitorTicketRequest
-	(*ConsumeMediaRequest)(nil),              // 19: jungletv.ConsumeMediaRequest
-	(*NowPlayingStubData)(nil),               // 20: jungletv.NowPlayingStubData
-	(*NowPlayingYouTubeVideoData)(nil),       // 21: jungletv.NowPlayingYouTubeVideoData
-	(*MediaConsumptionCheckpoint)(nil),       // 22: jungletv.MediaConsumptionCheckpoint
-	(*ActivityChallenge)(nil),                // 23: jungletv.ActivityChallenge
-	(*ProofOfWorkTask)(nil),                  // 24: jungletv.ProofOfWorkTask
-	(*MonitorQueueRequest)(nil),              // 25: jungletv.MonitorQueueRequest
-	(*Queue)(nil),                            // 26: jungletv.Queue
-	(*QueueYouTubeVideoData)(nil),            // 27: jungletv.QueueYouTubeVideoData
-	(*QueueEntry)(nil),                       // 28: jungletv.QueueEntry
-	(*User)(nil),                             // 29: jungletv.User
-	(*RewardInfoRequest)(nil),                // 30: jungletv.RewardInfoRequest
-	(*RewardInfoResponse)(nil),               // 31: jungletv.RewardInfoResponse
-	(*RemoveQueueEntryRequest)(nil),          // 32: jungletv.RemoveQueueEntryRequest
-	(*RemoveQueueEntryResponse)(nil),         // 33: jungletv.RemoveQueueEntryResponse
-	(*ForciblyEnqueueTicketRequest)(nil),     // 34: jungletv.ForciblyEnqueueTicketRequest
-	(*ForciblyEnqueueTicketResponse)(nil),    // 35: jungletv.ForciblyEnqueueTicketResponse
-	(*SubmitActivityChallengeRequest)(nil),   // 36: jungletv.SubmitActivityChallengeRequest
-	(*SubmitActivityChallengeResponse)(nil),  // 37: jungletv.SubmitActivityChallengeResponse
-	(*ConsumeChatRequest)(nil),               // 38: jungletv.ConsumeChatRequest
-	(*ChatUpdate)(nil),                       // 39: jungletv.ChatUpdate
-	(*ChatMessage)(nil),                      // 40: jungletv.ChatMessage
-	(*UserChatMessage)(nil),                  // 41: jungletv.UserChatMessage
-	(*SystemChatMessage)(nil),                // 42: jungletv.SystemChatMessage
-	(*ChatDisabledEvent)(nil),                // 43: jungletv.ChatDisabledEvent
-	(*ChatEnabledEvent)(nil),                 // 44: jungletv.ChatEnabledEvent
-	(*ChatMessageCreatedEvent)(nil),          // 45: jungletv.ChatMessageCreatedEvent
-	(*ChatMessageDeletedEvent)(nil),          // 46: jungletv.ChatMessageDeletedEvent
-	(*ChatHeartbeatEvent)(nil),               // 47: jungletv.ChatHeartbeatEvent
-	(*SendChatMessageRequest)(nil),           // 48: jungletv.SendChatMessageRequest
-	(*SendChatMessageResponse)(nil),          // 49: jungletv.SendChatMessageResponse
-	(*RemoveChatMessageRequest)(nil),         // 50: jungletv.RemoveChatMessageRequest
-	(*RemoveChatMessageResponse)(nil),        // 51: jungletv.RemoveChatMessageResponse
-	(*SetChatSettingsRequest)(nil),           // 52: jungletv.SetChatSettingsRequest
-	(*SetChatSettingsResponse)(nil),          // 53: jungletv.SetChatSettingsResponse
-	(*BanUserRequest)(nil),                   // 54: jungletv.BanUserRequest
-	(*BanUserResponse)(nil),                  // 55: jungletv.BanUserResponse
-	(*RemoveBanRequest)(nil),                 // 56: jungletv.RemoveBanRequest
-	(*RemoveBanResponse)(nil),                // 57: jungletv.RemoveBanResponse
-	(*SetVideoEnqueuingEnabledRequest)(nil),  // 58: jungletv.SetVideoEnqueuingEnabledRequest
-	(*SetVideoEnqueuingEnabledResponse)(nil), // 59: jungletv.SetVideoEnqueuingEnabledResponse
-	(*UserChatMessagesRequest)(nil),          // 60: jungletv.UserChatMessagesRequest
-	(*UserChatMessagesResponse)(nil),         // 61: jungletv.UserChatMessagesResponse
-	(*SubmitProofOfWorkRequest)(nil),         // 62: jungletv.SubmitProofOfWorkRequest
-	(*SubmitProofOfWorkResponse)(nil),        // 63: jungletv.SubmitProofOfWorkResponse
-	(*UserPermissionLevelRequest)(nil),       // 64: jungletv.UserPermissionLevelRequest
-	(*UserPermissionLevelResponse)(nil),      // 65: jungletv.UserPermissionLevelResponse
-	(*timestamppb.Timestamp)(nil),            // 66: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),              // 67: google.protobuf.Duration
+func (*SetAutoEnqueueListRequest) ProtoMessage() {}
+
+func (x *SetAutoEnqueueListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_jungletv_proto_depIdxs = []int32{
-	8,  // 0: jungletv.SignInProgress.verification:type_name -> jungletv.SignInVerification
-	10, // 1: jungletv.SignInProgress.response:type_name -> jungletv.SignInResponse
-	11, // 2: jungletv.SignInProgress.expired:type_name -> jungletv.SignInVerificationExpired
-	9,  // 3: jungletv.SignInProgress.account_unopened:type_name -> jungletv.SignInAccountUnopened
-	66, // 4: jungletv.SignInVerification.expiration:type_name -> google.protobuf.Timestamp
-	66, // 5: jungletv.SignInResponse.token_expiration:type_name -> google.protobuf.Timestamp
-	13, // 6: jungletv.EnqueueMediaRequest.stub_data:type_name -> jungletv.EnqueueStubData
-	12, // 7: jungletv.EnqueueMediaRequest.youtube_video_data:type_name -> jungletv.EnqueueYouTubeVideoData
-	17, // 8: jungletv.EnqueueMediaResponse.ticket:type_name -> jungletv.EnqueueMediaTicket
-	16, // 9: jungletv.EnqueueMediaResponse.failure:type_name -> jungletv.EnqueueMediaFailure
-	0,  // 10: jungletv.EnqueueMediaTicket.status:type_name -> jungletv.EnqueueMediaTicketStatus
-	66, // 11: jungletv.EnqueueMediaTicket.expiration:type_name -> google.protobuf.Timestamp
-	27, // 12: jungletv.EnqueueMediaTicket.youtube_video_data:type_name -> jungletv.QueueYouTubeVideoData
-	67, // 13: jungletv.MediaConsumptionCheckpoint.current_position:type_name -> google.protobuf.Duration
-	29, // 14: jungletv.MediaConsumptionCheckpoint.requested_by:type_name -> jungletv.User
-	23, // 15: jungletv.MediaConsumptionCheckpoint.activity_challenge:type_name -> jungletv.ActivityChallenge
-	24, // 16: jungletv.MediaConsumptionCheckpoint.pow_task:type_name -> jungletv.ProofOfWorkTask
-	20, // 17: jungletv.MediaConsumptionCheckpoint.stub_data:type_name -> jungletv.NowPlayingStubData
-	21, // 18: jungletv.MediaConsumptionCheckpoint.youtube_video_data:type_name -> jungletv.NowPlayingYouTubeVideoData
-	28, // 19: jungletv.Queue.entries:type_name -> jungletv.QueueEntry
-	29, // 20: jungletv.QueueEntry.requested_by:type_name -> jungletv.User
-	67, // 21: jungletv.QueueEntry.length:type_name -> google.protobuf.Duration
-	27, // 22: jungletv.QueueEntry.youtube_video_data:type_name -> jungletv.QueueYouTubeVideoData
-	1,  // 23: jungletv.User.roles:type_name -> jungletv.UserRole
-	2,  // 24: jungletv.ForciblyEnqueueTicketRequest.enqueue_type:type_name -> jungletv.ForcedTicketEnqueueType
-	43, // 25: jungletv.ChatUpdate.disabled:type_name -> jungletv.ChatDisabledEvent
-	44, // 26: jungletv.ChatUpdate.enabled:type_name -> jungletv.ChatEnabledEvent
-	45, // 27: jungletv.ChatUpdate.message_created:type_name -> jungletv.ChatMessageCreatedEvent
-	46, // 28: jungletv.ChatUpdate.message_deleted:type_name -> jungletv.ChatMessageDeletedEvent
-	47, // 29: jungletv.ChatUpdate.heartbeat:type_name -> jungletv.ChatHeartbeatEvent
-	66, // 30: jungletv.ChatMessage.created_at:type_name -> google.protobuf.Timestamp
-	41, // 31: jungletv.ChatMessage.user_message:type_name -> jungletv.UserChatMessage
-	42, // 32: jungletv.ChatMessage.system_message:type_name -> jungletv.SystemChatMessage
-	40, // 33: jungletv.ChatMessage.reference:type_name -> jungletv.ChatMessage
-	29, // 34: jungletv.UserChatMessage.author:type_name -> jungletv.User
-	3,  // 35: jungletv.ChatDisabledEvent.reason:type_name -> jungletv.ChatDisabledReason
-	40, // 36: jungletv.ChatMessageCreatedEvent.message:type_name -> jungletv.ChatMessage
-	4,  // 37: jungletv.SetVideoEnqueuingEnabledRequest.allowed:type_name -> jungletv.AllowedVideoEnqueuingType
-	40, // 38: jungletv.UserChatMessagesResponse.messages:type_name -> jungletv.ChatMessage
-	5,  // 39: jungletv.UserPermissionLevelResponse.permission_level:type_name -> jungletv.PermissionLevel
-	6,  // 40: jungletv.JungleTV.SignIn:input_type -> jungletv.SignInRequest
-	14, // 41: jungletv.JungleTV.EnqueueMedia:input_type -> jungletv.EnqueueMediaRequest
-	18, // 42: jungletv.JungleTV.MonitorTicket:input_type -> jungletv.MonitorTicketRequest
-	19, // 43: jungletv.JungleTV.ConsumeMedia:input_type -> jungletv.ConsumeMediaRequest
-	25, // 44: jungletv.JungleTV.MonitorQueue:input_type -> jungletv.MonitorQueueRequest
-	30, // 45: jungletv.JungleTV.RewardInfo:input_type -> jungletv.RewardInfoRequest
-	36, // 46: jungletv.JungleTV.SubmitActivityChallenge:input_type -> jungletv.SubmitActivityChallengeRequest
-	38, // 47: jungletv.JungleTV.ConsumeChat:input_type -> jungletv.ConsumeChatRequest
-	48, // 48: jungletv.JungleTV.SendChatMessage:input_type -> jungletv.SendChatMessageRequest
-	62, // 49: jungletv.JungleTV.SubmitProofOfWork:input_type -> jungletv.SubmitProofOfWorkRequest
-	64, // 50: jungletv.JungleTV.UserPermissionLevel:input_type -> jungletv.UserPermissionLevelRequest
-	34, // 51: jungletv.JungleTV.ForciblyEnqueueTicket:input_type -> jungletv.ForciblyEnqueueTicketRequest
-	32, // 52: jungletv.JungleTV.RemoveQueueEntry:input_type -> jungletv.RemoveQueueEntryRequest
-	50, // 53: jungletv.JungleTV.RemoveChatMessage:input_type -> jungletv.RemoveChatMessageRequest
-	52, // 54: jungletv.JungleTV.SetChatSettings:input_type -> jungletv.SetChatSettingsRequest
-	58, // 55: jungletv.JungleTV.SetVideoEnqueuingEnabled:input_type -> jungletv.SetVideoEnqueuingEnabledRequest
-	54, // 56: jungletv.JungleTV.BanUser:input_type -> jungletv.BanUserRequest
-	56, // 57: jungletv.JungleTV.RemoveBan:input_type -> jungletv.RemoveBanRequest
-	60, // 58: jungletv.JungleTV.UserChatMessages:input_type -> jungletv.UserChatMessagesRequest
-	7,  // 59: jungletv.JungleTV.SignIn:output_type -> jungletv.SignInProgress
-	15, // 60: jungletv.JungleTV.EnqueueMedia:output_type -> jungletv.EnqueueMediaResponse
-	17, // 61: jungletv.JungleTV.MonitorTicket:output_type -> jungletv.EnqueueMediaTicket
-	22, // 62: jungletv.JungleTV.ConsumeMedia:output_type -> jungletv.MediaConsumptionCheckpoint
-	26, // 63: jungletv.JungleTV.MonitorQueue:output_type -> jungletv.Queue
-	31, // 64: jungletv.JungleTV.RewardInfo:output_type -> jungletv.RewardInfoResponse
-	37, // 65: jungletv.JungleTV.SubmitActivityChallenge:output_type -> jungletv.SubmitActivityChallengeResponse
-	39, // 66: jungletv.JungleTV.ConsumeChat:output_type -> jungletv.ChatUpdate
-	49, // 67: jungletv.JungleTV.SendChatMessage:output_type -> jungletv.SendChatMessageResponse
-	63, // 68: jungletv.JungleTV.SubmitProofOfWork:output_type -> jungletv.SubmitProofOfWorkResponse
-	65, // 69: jungletv.JungleTV.UserPermissionLevel:output_type -> jungletv.UserPermissionLevelResponse
-	35, // 70: jungletv.JungleTV.ForciblyEnqueueTicket:output_type -> jungletv.ForciblyEnqueueTicketResponse
-	33, // 71: jungletv.JungleTV.RemoveQueueEntry:output_type -> jungletv.RemoveQueueEntryResponse
-	51, // 72: jungletv.JungleTV.RemoveChatMessage:output_type -> jungletv.RemoveChatMessageResponse
-	53, // 73: jungletv.JungleTV.SetChatSettings:output_type -> jungletv.SetChatSettingsResponse
-	59, // 74: jungletv.JungleTV.SetVideoEnqueuingEnabled:output_type -> jungletv.SetVideoEnqueuingEnabledResponse
-	55, // 75: jungletv.JungleTV.BanUser:output_type -> jungletv.BanUserResponse
-	57, // 76: jungletv.JungleTV.RemoveBan:output_type -> jungletv.RemoveBanResponse
-	61, // 77: jungletv.JungleTV.UserChatMessages:output_type -> jungletv.UserChatMessagesResponse
-	59, // [59:78] is the sub-list for method output_type
-	40, // [40:59] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+
+// Deprecated: Use SetAutoEnqueueListRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoEnqueueListRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{64}
 }
 
-func init() { file_jungletv_proto_init() }
-func file_jungletv_proto_init() {
-	if File_jungletv_proto != nil {
-		return
+func (x *SetAutoEnqueueListRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_jungletv_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInRequest); i {
+	return ""
+}
+
+func (x *SetAutoEnqueueListRequest) GetItems() []*AutoEnqueueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SetAutoEnqueueListRequest) GetRotations() []*AutoEnqueueRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+type SetAutoEnqueueListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetAutoEnqueueListResponse) Reset() {
+	*x = SetAutoEnqueueListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoEnqueueListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoEnqueueListResponse) ProtoMessage() {}
+
+func (x *SetAutoEnqueueListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoEnqueueListResponse.ProtoReflect.Descriptor instead.
+func (*SetAutoEnqueueListResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{65}
+}
+
+type PricingStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PricingStrategyRequest) Reset() {
+	*x = PricingStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingStrategyRequest) ProtoMessage() {}
+
+func (x *PricingStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingStrategyRequest.ProtoReflect.Descriptor instead.
+func (*PricingStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{66}
+}
+
+type PricingStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// parameters is a JSON object whose format depends on the strategy
+	Parameters          string   `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	AvailableStrategies []string `protobuf:"bytes,3,rep,name=available_strategies,json=availableStrategies,proto3" json:"available_strategies,omitempty"`
+}
+
+func (x *PricingStrategyResponse) Reset() {
+	*x = PricingStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingStrategyResponse) ProtoMessage() {}
+
+func (x *PricingStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingStrategyResponse.ProtoReflect.Descriptor instead.
+func (*PricingStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PricingStrategyResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *PricingStrategyResponse) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *PricingStrategyResponse) GetAvailableStrategies() []string {
+	if x != nil {
+		return x.AvailableStrategies
+	}
+	return nil
+}
+
+type SetPricingStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// parameters is a JSON object whose format depends on the strategy. Omitted parameters take their default values
+	Parameters string `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *SetPricingStrategyRequest) Reset() {
+	*x = SetPricingStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPricingStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingStrategyRequest) ProtoMessage() {}
+
+func (x *SetPricingStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingStrategyRequest.ProtoReflect.Descriptor instead.
+func (*SetPricingStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetPricingStrategyRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *SetPricingStrategyRequest) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+type SetPricingStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPricingStrategyResponse) Reset() {
+	*x = SetPricingStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPricingStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingStrategyResponse) ProtoMessage() {}
+
+func (x *SetPricingStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingStrategyResponse.ProtoReflect.Descriptor instead.
+func (*SetPricingStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{69}
+}
+
+type Voucher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string      `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type VoucherType `protobuf:"varint,2,opt,name=type,proto3,enum=jungletv.VoucherType" json:"type,omitempty"`
+	// percentage_discount is only used by PERCENTAGE_DISCOUNT vouchers
+	PercentageDiscount uint32 `protobuf:"varint,3,opt,name=percentage_discount,json=percentageDiscount,proto3" json:"percentage_discount,omitempty"`
+	// fixed_discount is only used by FIXED_DISCOUNT vouchers
+	FixedDiscount string `protobuf:"bytes,4,opt,name=fixed_discount,json=fixedDiscount,proto3" json:"fixed_discount,omitempty"`
+	// free_enqueue_max_length is only used by FREE_ENQUEUE vouchers
+	FreeEnqueueMaxLength *durationpb.Duration `protobuf:"bytes,5,opt,name=free_enqueue_max_length,json=freeEnqueueMaxLength,proto3" json:"free_enqueue_max_length,omitempty"`
+	// zero means unlimited
+	MaxRedemptions uint32 `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	// zero means unlimited
+	MaxRedemptionsPerUser uint32 `protobuf:"varint,7,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"`
+	// vouchers without expiration are valid until revoked
+	Expiration      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3,oneof" json:"expiration,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	RedemptionCount uint32                 `protobuf:"varint,11,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"`
+	Revoked         bool                   `protobuf:"varint,12,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (x *Voucher) Reset() {
+	*x = Voucher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Voucher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voucher) ProtoMessage() {}
+
+func (x *Voucher) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voucher.ProtoReflect.Descriptor instead.
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Voucher) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Voucher) GetType() VoucherType {
+	if x != nil {
+		return x.Type
+	}
+	return VoucherType_PERCENTAGE_DISCOUNT
+}
+
+func (x *Voucher) GetPercentageDiscount() uint32 {
+	if x != nil {
+		return x.PercentageDiscount
+	}
+	return 0
+}
+
+func (x *Voucher) GetFixedDiscount() string {
+	if x != nil {
+		return x.FixedDiscount
+	}
+	return ""
+}
+
+func (x *Voucher) GetFreeEnqueueMaxLength() *durationpb.Duration {
+	if x != nil {
+		return x.FreeEnqueueMaxLength
+	}
+	return nil
+}
+
+func (x *Voucher) GetMaxRedemptions() uint32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Voucher) GetMaxRedemptionsPerUser() uint32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *Voucher) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *Voucher) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Voucher) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Voucher) GetRedemptionCount() uint32 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *Voucher) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type MintVoucherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a random code is generated when the code is empty
+	Code                  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type                  VoucherType            `protobuf:"varint,2,opt,name=type,proto3,enum=jungletv.VoucherType" json:"type,omitempty"`
+	PercentageDiscount    uint32                 `protobuf:"varint,3,opt,name=percentage_discount,json=percentageDiscount,proto3" json:"percentage_discount,omitempty"`
+	FixedDiscount         string                 `protobuf:"bytes,4,opt,name=fixed_discount,json=fixedDiscount,proto3" json:"fixed_discount,omitempty"`
+	FreeEnqueueMaxLength  *durationpb.Duration   `protobuf:"bytes,5,opt,name=free_enqueue_max_length,json=freeEnqueueMaxLength,proto3" json:"free_enqueue_max_length,omitempty"`
+	MaxRedemptions        uint32                 `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser uint32                 `protobuf:"varint,7,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"`
+	Expiration            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3,oneof" json:"expiration,omitempty"`
+}
+
+func (x *MintVoucherRequest) Reset() {
+	*x = MintVoucherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintVoucherRequest) ProtoMessage() {}
+
+func (x *MintVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintVoucherRequest.ProtoReflect.Descriptor instead.
+func (*MintVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MintVoucherRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *MintVoucherRequest) GetType() VoucherType {
+	if x != nil {
+		return x.Type
+	}
+	return VoucherType_PERCENTAGE_DISCOUNT
+}
+
+func (x *MintVoucherRequest) GetPercentageDiscount() uint32 {
+	if x != nil {
+		return x.PercentageDiscount
+	}
+	return 0
+}
+
+func (x *MintVoucherRequest) GetFixedDiscount() string {
+	if x != nil {
+		return x.FixedDiscount
+	}
+	return ""
+}
+
+func (x *MintVoucherRequest) GetFreeEnqueueMaxLength() *durationpb.Duration {
+	if x != nil {
+		return x.FreeEnqueueMaxLength
+	}
+	return nil
+}
+
+func (x *MintVoucherRequest) GetMaxRedemptions() uint32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *MintVoucherRequest) GetMaxRedemptionsPerUser() uint32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *MintVoucherRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type MintVoucherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voucher *Voucher `protobuf:"bytes,1,opt,name=voucher,proto3" json:"voucher,omitempty"`
+}
+
+func (x *MintVoucherResponse) Reset() {
+	*x = MintVoucherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintVoucherResponse) ProtoMessage() {}
+
+func (x *MintVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintVoucherResponse.ProtoReflect.Descriptor instead.
+func (*MintVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MintVoucherResponse) GetVoucher() *Voucher {
+	if x != nil {
+		return x.Voucher
+	}
+	return nil
+}
+
+type VouchersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include_inactive includes vouchers that were revoked, expired or fully redeemed
+	IncludeInactive bool `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+}
+
+func (x *VouchersRequest) Reset() {
+	*x = VouchersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VouchersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VouchersRequest) ProtoMessage() {}
+
+func (x *VouchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VouchersRequest.ProtoReflect.Descriptor instead.
+func (*VouchersRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *VouchersRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type VouchersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vouchers []*Voucher `protobuf:"bytes,1,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
+}
+
+func (x *VouchersResponse) Reset() {
+	*x = VouchersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VouchersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VouchersResponse) ProtoMessage() {}
+
+func (x *VouchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VouchersResponse.ProtoReflect.Descriptor instead.
+func (*VouchersResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *VouchersResponse) GetVouchers() []*Voucher {
+	if x != nil {
+		return x.Vouchers
+	}
+	return nil
+}
+
+type RevokeVoucherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *RevokeVoucherRequest) Reset() {
+	*x = RevokeVoucherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeVoucherRequest) ProtoMessage() {}
+
+func (x *RevokeVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeVoucherRequest.ProtoReflect.Descriptor instead.
+func (*RevokeVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RevokeVoucherRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokeVoucherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeVoucherResponse) Reset() {
+	*x = RevokeVoucherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeVoucherResponse) ProtoMessage() {}
+
+func (x *RevokeVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeVoucherResponse.ProtoReflect.Descriptor instead.
+func (*RevokeVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{76}
+}
+
+type VoucherRedemptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *VoucherRedemptionsRequest) Reset() {
+	*x = VoucherRedemptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoucherRedemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherRedemptionsRequest) ProtoMessage() {}
+
+func (x *VoucherRedemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoucherRedemptionsRequest.ProtoReflect.Descriptor instead.
+func (*VoucherRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *VoucherRedemptionsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VoucherRedemptionsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *VoucherRedemptionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type VoucherRedemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code       string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RedeemedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	RedeemedBy *User                  `protobuf:"bytes,4,opt,name=redeemed_by,json=redeemedBy,proto3" json:"redeemed_by,omitempty"`
+	TicketId   string                 `protobuf:"bytes,5,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+}
+
+func (x *VoucherRedemption) Reset() {
+	*x = VoucherRedemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoucherRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherRedemption) ProtoMessage() {}
+
+func (x *VoucherRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoucherRedemption.ProtoReflect.Descriptor instead.
+func (*VoucherRedemption) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VoucherRedemption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VoucherRedemption) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VoucherRedemption) GetRedeemedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return nil
+}
+
+func (x *VoucherRedemption) GetRedeemedBy() *User {
+	if x != nil {
+		return x.RedeemedBy
+	}
+	return nil
+}
+
+func (x *VoucherRedemption) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type VoucherRedemptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// redemptions are sorted from most to least recent
+	Redemptions []*VoucherRedemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	Total       uint32               `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *VoucherRedemptionsResponse) Reset() {
+	*x = VoucherRedemptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoucherRedemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherRedemptionsResponse) ProtoMessage() {}
+
+func (x *VoucherRedemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoucherRedemptionsResponse.ProtoReflect.Descriptor instead.
+func (*VoucherRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *VoucherRedemptionsResponse) GetRedemptions() []*VoucherRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+func (x *VoucherRedemptionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ForciblyEnqueueTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnqueueType ForcedTicketEnqueueType `protobuf:"varint,2,opt,name=enqueue_type,json=enqueueType,proto3,enum=jungletv.ForcedTicketEnqueueType" json:"enqueue_type,omitempty"`
+}
+
+func (x *ForciblyEnqueueTicketRequest) Reset() {
+	*x = ForciblyEnqueueTicketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForciblyEnqueueTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForciblyEnqueueTicketRequest) ProtoMessage() {}
+
+func (x *ForciblyEnqueueTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForciblyEnqueueTicketRequest.ProtoReflect.Descriptor instead.
+func (*ForciblyEnqueueTicketRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ForciblyEnqueueTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForciblyEnqueueTicketRequest) GetEnqueueType() ForcedTicketEnqueueType {
+	if x != nil {
+		return x.EnqueueType
+	}
+	return ForcedTicketEnqueueType_ENQUEUE
+}
+
+type ForciblyEnqueueTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForciblyEnqueueTicketResponse) Reset() {
+	*x = ForciblyEnqueueTicketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForciblyEnqueueTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForciblyEnqueueTicketResponse) ProtoMessage() {}
+
+func (x *ForciblyEnqueueTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForciblyEnqueueTicketResponse.ProtoReflect.Descriptor instead.
+func (*ForciblyEnqueueTicketResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{81}
+}
+
+type SubmitActivityChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge       string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	CaptchaResponse string `protobuf:"bytes,2,opt,name=captcha_response,json=captchaResponse,proto3" json:"captcha_response,omitempty"`
+	Trusted         bool   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	ChannelId       string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *SubmitActivityChallengeRequest) Reset() {
+	*x = SubmitActivityChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitActivityChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityChallengeRequest) ProtoMessage() {}
+
+func (x *SubmitActivityChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityChallengeRequest.ProtoReflect.Descriptor instead.
+func (*SubmitActivityChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SubmitActivityChallengeRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *SubmitActivityChallengeRequest) GetCaptchaResponse() string {
+	if x != nil {
+		return x.CaptchaResponse
+	}
+	return ""
+}
+
+func (x *SubmitActivityChallengeRequest) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+func (x *SubmitActivityChallengeRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SubmitActivityChallengeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitActivityChallengeResponse) Reset() {
+	*x = SubmitActivityChallengeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitActivityChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityChallengeResponse) ProtoMessage() {}
+
+func (x *SubmitActivityChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityChallengeResponse.ProtoReflect.Descriptor instead.
+func (*SubmitActivityChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{83}
+}
+
+type ConsumeChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialHistorySize uint32 `protobuf:"varint,1,opt,name=initial_history_size,json=initialHistorySize,proto3" json:"initial_history_size,omitempty"`
+	ChannelId          string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ConsumeChatRequest) Reset() {
+	*x = ConsumeChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeChatRequest) ProtoMessage() {}
+
+func (x *ConsumeChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeChatRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeChatRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConsumeChatRequest) GetInitialHistorySize() uint32 {
+	if x != nil {
+		return x.InitialHistorySize
+	}
+	return 0
+}
+
+func (x *ConsumeChatRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ChatUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*ChatUpdate_Disabled
+	//	*ChatUpdate_Enabled
+	//	*ChatUpdate_MessageCreated
+	//	*ChatUpdate_MessageDeleted
+	//	*ChatUpdate_Heartbeat
+	Event isChatUpdate_Event `protobuf_oneof:"event"`
+}
+
+func (x *ChatUpdate) Reset() {
+	*x = ChatUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatUpdate) ProtoMessage() {}
+
+func (x *ChatUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatUpdate.ProtoReflect.Descriptor instead.
+func (*ChatUpdate) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{85}
+}
+
+func (m *ChatUpdate) GetEvent() isChatUpdate_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *ChatUpdate) GetDisabled() *ChatDisabledEvent {
+	if x, ok := x.GetEvent().(*ChatUpdate_Disabled); ok {
+		return x.Disabled
+	}
+	return nil
+}
+
+func (x *ChatUpdate) GetEnabled() *ChatEnabledEvent {
+	if x, ok := x.GetEvent().(*ChatUpdate_Enabled); ok {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *ChatUpdate) GetMessageCreated() *ChatMessageCreatedEvent {
+	if x, ok := x.GetEvent().(*ChatUpdate_MessageCreated); ok {
+		return x.MessageCreated
+	}
+	return nil
+}
+
+func (x *ChatUpdate) GetMessageDeleted() *ChatMessageDeletedEvent {
+	if x, ok := x.GetEvent().(*ChatUpdate_MessageDeleted); ok {
+		return x.MessageDeleted
+	}
+	return nil
+}
+
+func (x *ChatUpdate) GetHeartbeat() *ChatHeartbeatEvent {
+	if x, ok := x.GetEvent().(*ChatUpdate_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+type isChatUpdate_Event interface {
+	isChatUpdate_Event()
+}
+
+type ChatUpdate_Disabled struct {
+	Disabled *ChatDisabledEvent `protobuf:"bytes,1,opt,name=disabled,proto3,oneof"`
+}
+
+type ChatUpdate_Enabled struct {
+	Enabled *ChatEnabledEvent `protobuf:"bytes,2,opt,name=enabled,proto3,oneof"`
+}
+
+type ChatUpdate_MessageCreated struct {
+	MessageCreated *ChatMessageCreatedEvent `protobuf:"bytes,3,opt,name=message_created,json=messageCreated,proto3,oneof"`
+}
+
+type ChatUpdate_MessageDeleted struct {
+	MessageDeleted *ChatMessageDeletedEvent `protobuf:"bytes,4,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+}
+
+type ChatUpdate_Heartbeat struct {
+	Heartbeat *ChatHeartbeatEvent `protobuf:"bytes,5,opt,name=heartbeat,proto3,oneof"`
+}
+
+func (*ChatUpdate_Disabled) isChatUpdate_Event() {}
+
+func (*ChatUpdate_Enabled) isChatUpdate_Event() {}
+
+func (*ChatUpdate_MessageCreated) isChatUpdate_Event() {}
+
+func (*ChatUpdate_MessageDeleted) isChatUpdate_Event() {}
+
+func (*ChatUpdate_Heartbeat) isChatUpdate_Event() {}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Types that are assignable to Message:
+	//	*ChatMessage_UserMessage
+	//	*ChatMessage_SystemMessage
+	Message   isChatMessage_Message `protobuf_oneof:"message"`
+	Reference *ChatMessage          `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ChatMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetMessage() isChatMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetUserMessage() *UserChatMessage {
+	if x, ok := x.GetMessage().(*ChatMessage_UserMessage); ok {
+		return x.UserMessage
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetSystemMessage() *SystemChatMessage {
+	if x, ok := x.GetMessage().(*ChatMessage_SystemMessage); ok {
+		return x.SystemMessage
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetReference() *ChatMessage {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+type isChatMessage_Message interface {
+	isChatMessage_Message()
+}
+
+type ChatMessage_UserMessage struct {
+	UserMessage *UserChatMessage `protobuf:"bytes,3,opt,name=user_message,json=userMessage,proto3,oneof"`
+}
+
+type ChatMessage_SystemMessage struct {
+	SystemMessage *SystemChatMessage `protobuf:"bytes,4,opt,name=system_message,json=systemMessage,proto3,oneof"`
+}
+
+func (*ChatMessage_UserMessage) isChatMessage_Message() {}
+
+func (*ChatMessage_SystemMessage) isChatMessage_Message() {}
+
+type UserChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author  *User  `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UserChatMessage) Reset() {
+	*x = UserChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChatMessage) ProtoMessage() {}
+
+func (x *UserChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChatMessage.ProtoReflect.Descriptor instead.
+func (*UserChatMessage) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UserChatMessage) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *UserChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SystemChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SystemChatMessage) Reset() {
+	*x = SystemChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChatMessage) ProtoMessage() {}
+
+func (x *SystemChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChatMessage.ProtoReflect.Descriptor instead.
+func (*SystemChatMessage) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SystemChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ChatDisabledEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason ChatDisabledReason `protobuf:"varint,1,opt,name=reason,proto3,enum=jungletv.ChatDisabledReason" json:"reason,omitempty"`
+}
+
+func (x *ChatDisabledEvent) Reset() {
+	*x = ChatDisabledEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatDisabledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatDisabledEvent) ProtoMessage() {}
+
+func (x *ChatDisabledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatDisabledEvent.ProtoReflect.Descriptor instead.
+func (*ChatDisabledEvent) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ChatDisabledEvent) GetReason() ChatDisabledReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ChatDisabledReason_UNSPECIFIED
+}
+
+type ChatEnabledEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChatEnabledEvent) Reset() {
+	*x = ChatEnabledEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatEnabledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEnabledEvent) ProtoMessage() {}
+
+func (x *ChatEnabledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEnabledEvent.ProtoReflect.Descriptor instead.
+func (*ChatEnabledEvent) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{90}
+}
+
+type ChatMessageCreatedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ChatMessageCreatedEvent) Reset() {
+	*x = ChatMessageCreatedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessageCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageCreatedEvent) ProtoMessage() {}
+
+func (x *ChatMessageCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageCreatedEvent.ProtoReflect.Descriptor instead.
+func (*ChatMessageCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ChatMessageCreatedEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ChatMessageDeletedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChatMessageDeletedEvent) Reset() {
+	*x = ChatMessageDeletedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessageDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageDeletedEvent) ProtoMessage() {}
+
+func (x *ChatMessageDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageDeletedEvent.ProtoReflect.Descriptor instead.
+func (*ChatMessageDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ChatMessageDeletedEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ChatHeartbeatEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence uint32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ChatHeartbeatEvent) Reset() {
+	*x = ChatHeartbeatEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatHeartbeatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHeartbeatEvent) ProtoMessage() {}
+
+func (x *ChatHeartbeatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHeartbeatEvent.ProtoReflect.Descriptor instead.
+func (*ChatHeartbeatEvent) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ChatHeartbeatEvent) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content          string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Trusted          bool   `protobuf:"varint,2,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	ReplyReferenceId *int64 `protobuf:"varint,3,opt,name=reply_reference_id,json=replyReferenceId,proto3,oneof" json:"reply_reference_id,omitempty"`
+	ChannelId        string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SendChatMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+func (x *SendChatMessageRequest) GetReplyReferenceId() int64 {
+	if x != nil && x.ReplyReferenceId != nil {
+		return *x.ReplyReferenceId
+	}
+	return 0
+}
+
+func (x *SendChatMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SendChatMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendChatMessageResponse) Reset() {
+	*x = SendChatMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageResponse) ProtoMessage() {}
+
+func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SendChatMessageResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveChatMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *RemoveChatMessageRequest) Reset() {
+	*x = RemoveChatMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatMessageRequest) ProtoMessage() {}
+
+func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RemoveChatMessageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveChatMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type RemoveChatMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveChatMessageResponse) Reset() {
+	*x = RemoveChatMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatMessageResponse) ProtoMessage() {}
+
+func (x *RemoveChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{97}
+}
+
+type SetChatSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled   bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Slowmode  bool   `protobuf:"varint,2,opt,name=slowmode,proto3" json:"slowmode,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *SetChatSettingsRequest) Reset() {
+	*x = SetChatSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChatSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatSettingsRequest) ProtoMessage() {}
+
+func (x *SetChatSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetChatSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetChatSettingsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetChatSettingsRequest) GetSlowmode() bool {
+	if x != nil {
+		return x.Slowmode
+	}
+	return false
+}
+
+func (x *SetChatSettingsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SetChatSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetChatSettingsResponse) Reset() {
+	*x = SetChatSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChatSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatSettingsResponse) ProtoMessage() {}
+
+func (x *SetChatSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetChatSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{99}
+}
+
+type BanUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RemoteAddress   string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	ChatBanned      bool   `protobuf:"varint,3,opt,name=chat_banned,json=chatBanned,proto3" json:"chat_banned,omitempty"`
+	EnqueuingBanned bool   `protobuf:"varint,4,opt,name=enqueuing_banned,json=enqueuingBanned,proto3" json:"enqueuing_banned,omitempty"`
+	RewardsBanned   bool   `protobuf:"varint,5,opt,name=rewards_banned,json=rewardsBanned,proto3" json:"rewards_banned,omitempty"`
+	Reason          string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BanUserRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetChatBanned() bool {
+	if x != nil {
+		return x.ChatBanned
+	}
+	return false
+}
+
+func (x *BanUserRequest) GetEnqueuingBanned() bool {
+	if x != nil {
+		return x.EnqueuingBanned
+	}
+	return false
+}
+
+func (x *BanUserRequest) GetRewardsBanned() bool {
+	if x != nil {
+		return x.RewardsBanned
+	}
+	return false
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BanIds []string `protobuf:"bytes,1,rep,name=ban_ids,json=banIds,proto3" json:"ban_ids,omitempty"`
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BanUserResponse) GetBanIds() []string {
+	if x != nil {
+		return x.BanIds
+	}
+	return nil
+}
+
+type RemoveBanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BanId  string `protobuf:"bytes,1,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RemoveBanRequest) Reset() {
+	*x = RemoveBanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBanRequest) ProtoMessage() {}
+
+func (x *RemoveBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBanRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBanRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RemoveBanRequest) GetBanId() string {
+	if x != nil {
+		return x.BanId
+	}
+	return ""
+}
+
+func (x *RemoveBanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RemoveBanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveBanResponse) Reset() {
+	*x = RemoveBanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBanResponse) ProtoMessage() {}
+
+func (x *RemoveBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBanResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBanResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{103}
+}
+
+type SetVideoEnqueuingEnabledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed   AllowedVideoEnqueuingType `protobuf:"varint,1,opt,name=allowed,proto3,enum=jungletv.AllowedVideoEnqueuingType" json:"allowed,omitempty"`
+	ChannelId string                    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *SetVideoEnqueuingEnabledRequest) Reset() {
+	*x = SetVideoEnqueuingEnabledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVideoEnqueuingEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoEnqueuingEnabledRequest) ProtoMessage() {}
+
+func (x *SetVideoEnqueuingEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoEnqueuingEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetVideoEnqueuingEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetVideoEnqueuingEnabledRequest) GetAllowed() AllowedVideoEnqueuingType {
+	if x != nil {
+		return x.Allowed
+	}
+	return AllowedVideoEnqueuingType_DISABLED
+}
+
+func (x *SetVideoEnqueuingEnabledRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SetVideoEnqueuingEnabledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVideoEnqueuingEnabledResponse) Reset() {
+	*x = SetVideoEnqueuingEnabledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVideoEnqueuingEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoEnqueuingEnabledResponse) ProtoMessage() {}
+
+func (x *SetVideoEnqueuingEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoEnqueuingEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetVideoEnqueuingEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{105}
+}
+
+type UserChatMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NumMessages uint32 `protobuf:"varint,2,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+	ChannelId   string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *UserChatMessagesRequest) Reset() {
+	*x = UserChatMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChatMessagesRequest) ProtoMessage() {}
+
+func (x *UserChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*UserChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UserChatMessagesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserChatMessagesRequest) GetNumMessages() uint32 {
+	if x != nil {
+		return x.NumMessages
+	}
+	return 0
+}
+
+func (x *UserChatMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type UserChatMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *UserChatMessagesResponse) Reset() {
+	*x = UserChatMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChatMessagesResponse) ProtoMessage() {}
+
+func (x *UserChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*UserChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UserChatMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SubmitProofOfWorkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Previous []byte `protobuf:"bytes,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	Work     []byte `protobuf:"bytes,2,opt,name=work,proto3" json:"work,omitempty"`
+}
+
+func (x *SubmitProofOfWorkRequest) Reset() {
+	*x = SubmitProofOfWorkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitProofOfWorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProofOfWorkRequest) ProtoMessage() {}
+
+func (x *SubmitProofOfWorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProofOfWorkRequest.ProtoReflect.Descriptor instead.
+func (*SubmitProofOfWorkRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SubmitProofOfWorkRequest) GetPrevious() []byte {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *SubmitProofOfWorkRequest) GetWork() []byte {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+type SubmitProofOfWorkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitProofOfWorkResponse) Reset() {
+	*x = SubmitProofOfWorkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitProofOfWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProofOfWorkResponse) ProtoMessage() {}
+
+func (x *SubmitProofOfWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProofOfWorkResponse.ProtoReflect.Descriptor instead.
+func (*SubmitProofOfWorkResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{109}
+}
+
+type UserPermissionLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserPermissionLevelRequest) Reset() {
+	*x = UserPermissionLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPermissionLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissionLevelRequest) ProtoMessage() {}
+
+func (x *UserPermissionLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissionLevelRequest.ProtoReflect.Descriptor instead.
+func (*UserPermissionLevelRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{110}
+}
+
+type UserPermissionLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionLevel PermissionLevel `protobuf:"varint,1,opt,name=permission_level,json=permissionLevel,proto3,enum=jungletv.PermissionLevel" json:"permission_level,omitempty"`
+}
+
+func (x *UserPermissionLevelResponse) Reset() {
+	*x = UserPermissionLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPermissionLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissionLevelResponse) ProtoMessage() {}
+
+func (x *UserPermissionLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissionLevelResponse.ProtoReflect.Descriptor instead.
+func (*UserPermissionLevelResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UserPermissionLevelResponse) GetPermissionLevel() PermissionLevel {
+	if x != nil {
+		return x.PermissionLevel
+	}
+	return PermissionLevel_UNAUTHENTICATED
+}
+
+type PlayedMediaHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset    uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *PlayedMediaHistoryRequest) Reset() {
+	*x = PlayedMediaHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayedMediaHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayedMediaHistoryRequest) ProtoMessage() {}
+
+func (x *PlayedMediaHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayedMediaHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PlayedMediaHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PlayedMediaHistoryRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PlayedMediaHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PlayedMediaHistoryRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type PlayedMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry              *QueueEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	PlayedFor          *durationpb.Duration   `protobuf:"bytes,4,opt,name=played_for,json=playedFor,proto3" json:"played_for,omitempty"`
+	RewardedSpectators uint32                 `protobuf:"varint,5,opt,name=rewarded_spectators,json=rewardedSpectators,proto3" json:"rewarded_spectators,omitempty"`
+	RewardPerSpectator string                 `protobuf:"bytes,6,opt,name=reward_per_spectator,json=rewardPerSpectator,proto3" json:"reward_per_spectator,omitempty"`
+}
+
+func (x *PlayedMedia) Reset() {
+	*x = PlayedMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayedMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayedMedia) ProtoMessage() {}
+
+func (x *PlayedMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayedMedia.ProtoReflect.Descriptor instead.
+func (*PlayedMedia) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *PlayedMedia) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *PlayedMedia) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PlayedMedia) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *PlayedMedia) GetPlayedFor() *durationpb.Duration {
+	if x != nil {
+		return x.PlayedFor
+	}
+	return nil
+}
+
+func (x *PlayedMedia) GetRewardedSpectators() uint32 {
+	if x != nil {
+		return x.RewardedSpectators
+	}
+	return 0
+}
+
+func (x *PlayedMedia) GetRewardPerSpectator() string {
+	if x != nil {
+		return x.RewardPerSpectator
+	}
+	return ""
+}
+
+type PlayedMediaHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayedMedia []*PlayedMedia `protobuf:"bytes,1,rep,name=played_media,json=playedMedia,proto3" json:"played_media,omitempty"`
+	Total       uint32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PlayedMediaHistoryResponse) Reset() {
+	*x = PlayedMediaHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayedMediaHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayedMediaHistoryResponse) ProtoMessage() {}
+
+func (x *PlayedMediaHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayedMediaHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PlayedMediaHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PlayedMediaHistoryResponse) GetPlayedMedia() []*PlayedMedia {
+	if x != nil {
+		return x.PlayedMedia
+	}
+	return nil
+}
+
+func (x *PlayedMediaHistoryResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PrepaidBalanceStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *PrepaidBalanceStatementRequest) Reset() {
+	*x = PrepaidBalanceStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepaidBalanceStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepaidBalanceStatementRequest) ProtoMessage() {}
+
+func (x *PrepaidBalanceStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepaidBalanceStatementRequest.ProtoReflect.Descriptor instead.
+func (*PrepaidBalanceStatementRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PrepaidBalanceStatementRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PrepaidBalanceStatementRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PrepaidBalanceStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deposit_address is where money must be sent to in order to add to the balance
+	DepositAddress string `protobuf:"bytes,1,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty"`
+	Balance        string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// entries are ordered from the most recent to the oldest
+	Entries []*PrepaidBalanceLedgerEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total   uint32                       `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PrepaidBalanceStatementResponse) Reset() {
+	*x = PrepaidBalanceStatementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepaidBalanceStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepaidBalanceStatementResponse) ProtoMessage() {}
+
+func (x *PrepaidBalanceStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepaidBalanceStatementResponse.ProtoReflect.Descriptor instead.
+func (*PrepaidBalanceStatementResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PrepaidBalanceStatementResponse) GetDepositAddress() string {
+	if x != nil {
+		return x.DepositAddress
+	}
+	return ""
+}
+
+func (x *PrepaidBalanceStatementResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *PrepaidBalanceStatementResponse) GetEntries() []*PrepaidBalanceLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *PrepaidBalanceStatementResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PrepaidBalanceLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	At   *timestamppb.Timestamp        `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Type PrepaidBalanceLedgerEntryType `protobuf:"varint,3,opt,name=type,proto3,enum=jungletv.PrepaidBalanceLedgerEntryType" json:"type,omitempty"`
+	// amount is negative for money taken from the balance
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TicketId  string `protobuf:"bytes,5,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *PrepaidBalanceLedgerEntry) Reset() {
+	*x = PrepaidBalanceLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepaidBalanceLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepaidBalanceLedgerEntry) ProtoMessage() {}
+
+func (x *PrepaidBalanceLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepaidBalanceLedgerEntry.ProtoReflect.Descriptor instead.
+func (*PrepaidBalanceLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetType() PrepaidBalanceLedgerEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return PrepaidBalanceLedgerEntryType_DEPOSIT
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *PrepaidBalanceLedgerEntry) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type WithdrawPrepaidBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount to send to the reward address. The whole balance is withdrawn when empty
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *WithdrawPrepaidBalanceRequest) Reset() {
+	*x = WithdrawPrepaidBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawPrepaidBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawPrepaidBalanceRequest) ProtoMessage() {}
+
+func (x *WithdrawPrepaidBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawPrepaidBalanceRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawPrepaidBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *WithdrawPrepaidBalanceRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type WithdrawPrepaidBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Balance   string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *WithdrawPrepaidBalanceResponse) Reset() {
+	*x = WithdrawPrepaidBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawPrepaidBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawPrepaidBalanceResponse) ProtoMessage() {}
+
+func (x *WithdrawPrepaidBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawPrepaidBalanceResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawPrepaidBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *WithdrawPrepaidBalanceResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawPrepaidBalanceResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *WithdrawPrepaidBalanceResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type ChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelsRequest) Reset() {
+	*x = ChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelsRequest) ProtoMessage() {}
+
+func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{120}
+}
+
+// Channel is an independent stream with its own queue, chat and rewards.
+// Requests with a channel_id field target the main channel when it is left empty
+type Channel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentlyWatching uint32 `protobuf:"varint,3,opt,name=currently_watching,json=currentlyWatching,proto3" json:"currently_watching,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Channel) ProtoMessage() {}
+
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Channel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Channel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Channel) GetCurrentlyWatching() uint32 {
+	if x != nil {
+		return x.CurrentlyWatching
+	}
+	return 0
+}
+
+type ChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ChannelsResponse) Reset() {
+	*x = ChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jungletv_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelsResponse) ProtoMessage() {}
+
+func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jungletv_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_jungletv_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+var File_jungletv_proto protoreflect.FileDescriptor
+
+var file_jungletv_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x0d, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75,
+	0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f, 0x70, 0x65, 0x6e, 0x65,
+	0x64, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x53, 0x69,
+	0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4e, 0x0a, 0x23, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a,
+	0x19, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x96,
+	0x01, 0x0a, 0x16, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x1a, 0x45, 0x6e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x22, 0xfd, 0x05, 0x0a, 0x13, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x12,
+	0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75,
+	0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79,
+	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x4e, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x5a, 0x0a, 0x15, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61,
+	0x79, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x5b, 0x0a, 0x18, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x16, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x45, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x13, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x6c, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x75,
+	0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x69,
+	0x6e, 0x67, 0x49, 0x73, 0x55, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x52, 0x0a, 0x17, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x65, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x55, 0x0a, 0x19, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x18, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x6f,
+	0x77, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22,
+	0xaa, 0x09, 0x0a, 0x12, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x20,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x55, 0x6e, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
+	0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x15, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x79, 0x6f, 0x75, 0x74, 0x75,
+	0x62, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x52, 0x0a,
+	0x17, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x65, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x55, 0x0a, 0x19, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x18, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x5b, 0x0a, 0x18, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x16, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x33, 0x0a, 0x14,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x22, 0x62, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x50, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa4, 0x01, 0x0a, 0x1a,
+	0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62,
+	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x4c, 0x0a, 0x19, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xf0, 0x05, 0x0a, 0x1a, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x1b, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x12,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x48, 0x02, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x08, 0x70, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x03, 0x52, 0x07, 0x70, 0x6f,
+	0x77, 0x54, 0x61, 0x73, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x62,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x62, 0x44, 0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
+	0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4e, 0x6f, 0x77,
+	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79, 0x6f, 0x75, 0x74, 0x75,
+	0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x11, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x77, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x22, 0x37, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x45, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x22, 0x56, 0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x22, 0xeb, 0x01, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0c, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x22, 0xbc, 0x02,
+	0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x64,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x65, 0x61,
+	0x64, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3d, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29,
+	0x0a, 0x11, 0x51, 0x75, 0x65, 0x75, 0x65, 0x48, 0x65, 0x61, 0x64, 0x41, 0x64, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f,
+	0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xb3, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6f, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6f, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x13,
+	0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x5d, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x99, 0x04, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x31, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75,
+	0x6e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x79, 0x6f,
+	0x75, 0x74, 0x75, 0x62, 0x65, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x79, 0x6f, 0x75, 0x74, 0x75,
+	0x62, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x11, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x62, 0x0a, 0x16,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4a, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3b, 0x0a, 0x12, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x44,
+	0x0a, 0x14, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x1a,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x9a, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x65, 0x61, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x1b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
+	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x48, 0x0a, 0x17,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x08,
+	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x51, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa2, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a,
+	0x16, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x6f, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x2b, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0x37, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x41, 0x75,
+	0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
+	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1c,
+	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16,
+	0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31,
+	0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65,
+	0x73, 0x22, 0x57, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x04, 0x0a, 0x07, 0x56, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x17, 0x66,
+	0x72, 0x65, 0x65, 0x5f, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x66, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x3f, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf,
+	0x03, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x17,
+	0x66, 0x72, 0x65, 0x65, 0x5f, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x66, 0x72, 0x65, 0x65, 0x45, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x3f, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x42, 0x0a, 0x13, 0x4d, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x76, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x0f, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x08, 0x76, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x19, 0x56, 0x6f,
+	0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x56, 0x6f,
+	0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x71,
+	0x0a, 0x1a, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x74, 0x0a, 0x1c, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x44, 0x0a, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x69,
+	0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x21, 0x0a,
+	0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x65, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xe2, 0x02, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb5, 0x02, 0x0a,
+	0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x53, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x11, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x74,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x22, 0x29, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x18, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x6c, 0x6f, 0x77, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x6c, 0x6f, 0x77, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74,
+	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x2a, 0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x10,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x13, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x17, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x22, 0x4d, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66,
+	0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x1b, 0x0a, 0x19, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x68, 0x0a, 0x19, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xc8, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x66, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x2f, 0x0a,
+	0x13, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x6c, 0x0a, 0x1a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x4e,
+	0x0a, 0x1e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb9,
+	0x01, 0x0a, 0x1f, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xe8, 0x01, 0x0a, 0x19, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x61, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x37, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71,
+	0x0a, 0x1e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79,
+	0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x22, 0x41, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2a, 0x3d, 0x0a, 0x18, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x19, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x2a,
+	0x70, 0x0a, 0x18, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x44,
+	0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x49, 0x4d, 0x42, 0x55, 0x52,
+	0x53, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x41, 0x59, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x43, 0x41, 0x52, 0x52, 0x49, 0x45, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10,
+	0x03, 0x2a, 0x50, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x56, 0x45, 0x5f,
+	0x55, 0x50, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x54, 0x4f, 0x5f, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x57, 0x41,
+	0x50, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0b, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46,
+	0x49, 0x58, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10,
+	0x02, 0x2a, 0x43, 0x0a, 0x17, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x41,
+	0x59, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4c, 0x41, 0x59,
+	0x5f, 0x4e, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x40, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50,
+	0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x19, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x4f, 0x4e, 0x4c,
+	0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x3b, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x50, 0x0a,
+	0x1d, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54,
+	0x49, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x10, 0x02, 0x32,
+	0xd6, 0x1a, 0x0a, 0x08, 0x4a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x56, 0x12, 0x3f, 0x0a, 0x06,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12, 0x17, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a,
+	0x0c, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1d, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1d, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12,
+	0x28, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
+	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a,
+	0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24,
+	0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
+	0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x28, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x27, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x46, 0x6f,
+	0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x26, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46,
+	0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1e, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x12, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55,
+	0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x12, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x08, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x56, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6a, 0x75, 0x6e, 0x67,
+	0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x75,
+	0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65,
+	0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c,
+	0x65, 0x74, 0x76, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x53,
+	0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e,
+	0x12, 0x1a, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6a,
+	0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x21, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x76, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6e, 0x79, 0x69, 0x6d, 0x2f, 0x6a, 0x75, 0x6e,
+	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_jungletv_proto_rawDescOnce sync.Once
+	file_jungletv_proto_rawDescData = file_jungletv_proto_rawDesc
+)
+
+func file_jungletv_proto_rawDescGZIP() []byte {
+	file_jungletv_proto_rawDescOnce.Do(func() {
+		file_jungletv_proto_rawDescData = protoimpl.X.CompressGZIP(file_jungletv_proto_rawDescData)
+	})
+	return file_jungletv_proto_rawDescData
+}
+
+var file_jungletv_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_jungletv_proto_msgTypes = make([]protoimpl.MessageInfo, 123)
+var file_jungletv_proto_goTypes = []interface{}{
+	(EnqueueMediaTicketStatus)(0),            // 0: jungletv.EnqueueMediaTicketStatus
+	(UserRole)(0),                            // 1: jungletv.UserRole
+	(RewardDistributionStatus)(0),            // 2: jungletv.RewardDistributionStatus
+	(QueueEntryMovement)(0),                  // 3: jungletv.QueueEntryMovement
+	(VoucherType)(0),                         // 4: jungletv.VoucherType
+	(ForcedTicketEnqueueType)(0),             // 5: jungletv.ForcedTicketEnqueueType
+	(ChatDisabledReason)(0),                  // 6: jungletv.ChatDisabledReason
+	(AllowedVideoEnqueuingType)(0),           // 7: jungletv.AllowedVideoEnqueuingType
+	(PermissionLevel)(0),                     // 8: jungletv.PermissionLevel
+	(PrepaidBalanceLedgerEntryType)(0),       // 9: jungletv.PrepaidBalanceLedgerEntryType
+	(*SignInRequest)(nil),                    // 10: jungletv.SignInRequest
+	(*SignInProgress)(nil),                   // 11: jungletv.SignInProgress
+	(*SignInVerification)(nil),               // 12: jungletv.SignInVerification
+	(*SignInAccountUnopened)(nil),            // 13: jungletv.SignInAccountUnopened
+	(*SignInResponse)(nil),                   // 14: jungletv.SignInResponse
+	(*SignInVerificationExpired)(nil),        // 15: jungletv.SignInVerificationExpired
+	(*EnqueueYouTubeVideoData)(nil),          // 16: jungletv.EnqueueYouTubeVideoData
+	(*EnqueueDirectMediaData)(nil),           // 17: jungletv.EnqueueDirectMediaData
+	(*EnqueueYouTubePlaylistData)(nil),       // 18: jungletv.EnqueueYouTubePlaylistData
+	(*EnqueueStubData)(nil),                  // 19: jungletv.EnqueueStubData
+	(*EnqueueMediaRequest)(nil),              // 20: jungletv.EnqueueMediaRequest
+	(*EnqueueMediaResponse)(nil),             // 21: jungletv.EnqueueMediaResponse
+	(*EnqueueMediaFailure)(nil),              // 22: jungletv.EnqueueMediaFailure
+	(*GetEnqueuePricingResponse)(nil),        // 23: jungletv.GetEnqueuePricingResponse
+	(*EnqueuePricingQuote)(nil),              // 24: jungletv.EnqueuePricingQuote
+	(*EnqueueMediaTicket)(nil),               // 25: jungletv.EnqueueMediaTicket
+	(*MonitorTicketRequest)(nil),             // 26: jungletv.MonitorTicketRequest
+	(*ConsumeMediaRequest)(nil),              // 27: jungletv.ConsumeMediaRequest
+	(*NowPlayingStubData)(nil),               // 28: jungletv.NowPlayingStubData
+	(*NowPlayingYouTubeVideoData)(nil),       // 29: jungletv.NowPlayingYouTubeVideoData
+	(*NowPlayingDirectMediaData)(nil),        // 30: jungletv.NowPlayingDirectMediaData
+	(*MediaConsumptionCheckpoint)(nil),       // 31: jungletv.MediaConsumptionCheckpoint
+	(*ActivityChallenge)(nil),                // 32: jungletv.ActivityChallenge
+	(*ProofOfWorkTask)(nil),                  // 33: jungletv.ProofOfWorkTask
+	(*MonitorQueueRequest)(nil),              // 34: jungletv.MonitorQueueRequest
+	(*Queue)(nil),                            // 35: jungletv.Queue
+	(*QueueChanges)(nil),                     // 36: jungletv.QueueChanges
+	(*QueueChange)(nil),                      // 37: jungletv.QueueChange
+	(*QueueEntryInserted)(nil),               // 38: jungletv.QueueEntryInserted
+	(*QueueEntryRemoved)(nil),                // 39: jungletv.QueueEntryRemoved
+	(*QueueEntryMoved)(nil),                  // 40: jungletv.QueueEntryMoved
+	(*QueueHeadAdvanced)(nil),                // 41: jungletv.QueueHeadAdvanced
+	(*QueueEntryUpdated)(nil),                // 42: jungletv.QueueEntryUpdated
+	(*ScheduledMedia)(nil),                   // 43: jungletv.ScheduledMedia
+	(*QueueYouTubeVideoData)(nil),            // 44: jungletv.QueueYouTubeVideoData
+	(*QueueYouTubePlaylistData)(nil),         // 45: jungletv.QueueYouTubePlaylistData
+	(*SkippedPlaylistItem)(nil),              // 46: jungletv.SkippedPlaylistItem
+	(*QueueDirectMediaData)(nil),             // 47: jungletv.QueueDirectMediaData
+	(*QueueEntry)(nil),                       // 48: jungletv.QueueEntry
+	(*QueueEntryContribution)(nil),           // 49: jungletv.QueueEntryContribution
+	(*User)(nil),                             // 50: jungletv.User
+	(*RewardInfoRequest)(nil),                // 51: jungletv.RewardInfoRequest
+	(*RewardInfoResponse)(nil),               // 52: jungletv.RewardInfoResponse
+	(*RewardHistoryRequest)(nil),             // 53: jungletv.RewardHistoryRequest
+	(*ReceivedReward)(nil),                   // 54: jungletv.ReceivedReward
+	(*RewardHistoryResponse)(nil),            // 55: jungletv.RewardHistoryResponse
+	(*RewardDistributionsRequest)(nil),       // 56: jungletv.RewardDistributionsRequest
+	(*RewardRecipient)(nil),                  // 57: jungletv.RewardRecipient
+	(*RewardDistribution)(nil),               // 58: jungletv.RewardDistribution
+	(*RewardDistributionsResponse)(nil),      // 59: jungletv.RewardDistributionsResponse
+	(*RemoveQueueEntryRequest)(nil),          // 60: jungletv.RemoveQueueEntryRequest
+	(*RemoveQueueEntryResponse)(nil),         // 61: jungletv.RemoveQueueEntryResponse
+	(*MoveQueueEntryRequest)(nil),            // 62: jungletv.MoveQueueEntryRequest
+	(*MoveQueueEntryResponse)(nil),           // 63: jungletv.MoveQueueEntryResponse
+	(*SetPlaybackPausedRequest)(nil),         // 64: jungletv.SetPlaybackPausedRequest
+	(*SetPlaybackPausedResponse)(nil),        // 65: jungletv.SetPlaybackPausedResponse
+	(*ScheduleMediaRequest)(nil),             // 66: jungletv.ScheduleMediaRequest
+	(*ScheduleMediaResponse)(nil),            // 67: jungletv.ScheduleMediaResponse
+	(*UnscheduleMediaRequest)(nil),           // 68: jungletv.UnscheduleMediaRequest
+	(*UnscheduleMediaResponse)(nil),          // 69: jungletv.UnscheduleMediaResponse
+	(*AutoEnqueueItem)(nil),                  // 70: jungletv.AutoEnqueueItem
+	(*AutoEnqueueRotation)(nil),              // 71: jungletv.AutoEnqueueRotation
+	(*AutoEnqueueListRequest)(nil),           // 72: jungletv.AutoEnqueueListRequest
+	(*AutoEnqueueListResponse)(nil),          // 73: jungletv.AutoEnqueueListResponse
+	(*SetAutoEnqueueListRequest)(nil),        // 74: jungletv.SetAutoEnqueueListRequest
+	(*SetAutoEnqueueListResponse)(nil),       // 75: jungletv.SetAutoEnqueueListResponse
+	(*PricingStrategyRequest)(nil),           // 76: jungletv.PricingStrategyRequest
+	(*PricingStrategyResponse)(nil),          // 77: jungletv.PricingStrategyResponse
+	(*SetPricingStrategyRequest)(nil),        // 78: jungletv.SetPricingStrategyRequest
+	(*SetPricingStrategyResponse)(nil),       // 79: jungletv.SetPricingStrategyResponse
+	(*Voucher)(nil),                          // 80: jungletv.Voucher
+	(*MintVoucherRequest)(nil),               // 81: jungletv.MintVoucherRequest
+	(*MintVoucherResponse)(nil),              // 82: jungletv.MintVoucherResponse
+	(*VouchersRequest)(nil),                  // 83: jungletv.VouchersRequest
+	(*VouchersResponse)(nil),                 // 84: jungletv.VouchersResponse
+	(*RevokeVoucherRequest)(nil),             // 85: jungletv.RevokeVoucherRequest
+	(*RevokeVoucherResponse)(nil),            // 86: jungletv.RevokeVoucherResponse
+	(*VoucherRedemptionsRequest)(nil),        // 87: jungletv.VoucherRedemptionsRequest
+	(*VoucherRedemption)(nil),                // 88: jungletv.VoucherRedemption
+	(*VoucherRedemptionsResponse)(nil),       // 89: jungletv.VoucherRedemptionsResponse
+	(*ForciblyEnqueueTicketRequest)(nil),     // 90: jungletv.ForciblyEnqueueTicketRequest
+	(*ForciblyEnqueueTicketResponse)(nil),    // 91: jungletv.ForciblyEnqueueTicketResponse
+	(*SubmitActivityChallengeRequest)(nil),   // 92: jungletv.SubmitActivityChallengeRequest
+	(*SubmitActivityChallengeResponse)(nil),  // 93: jungletv.SubmitActivityChallengeResponse
+	(*ConsumeChatRequest)(nil),               // 94: jungletv.ConsumeChatRequest
+	(*ChatUpdate)(nil),                       // 95: jungletv.ChatUpdate
+	(*ChatMessage)(nil),                      // 96: jungletv.ChatMessage
+	(*UserChatMessage)(nil),                  // 97: jungletv.UserChatMessage
+	(*SystemChatMessage)(nil),                // 98: jungletv.SystemChatMessage
+	(*ChatDisabledEvent)(nil),                // 99: jungletv.ChatDisabledEvent
+	(*ChatEnabledEvent)(nil),                 // 100: jungletv.ChatEnabledEvent
+	(*ChatMessageCreatedEvent)(nil),          // 101: jungletv.ChatMessageCreatedEvent
+	(*ChatMessageDeletedEvent)(nil),          // 102: jungletv.ChatMessageDeletedEvent
+	(*ChatHeartbeatEvent)(nil),               // 103: jungletv.ChatHeartbeatEvent
+	(*SendChatMessageRequest)(nil),           // 104: jungletv.SendChatMessageRequest
+	(*SendChatMessageResponse)(nil),          // 105: jungletv.SendChatMessageResponse
+	(*RemoveChatMessageRequest)(nil),         // 106: jungletv.RemoveChatMessageRequest
+	(*RemoveChatMessageResponse)(nil),        // 107: jungletv.RemoveChatMessageResponse
+	(*SetChatSettingsRequest)(nil),           // 108: jungletv.SetChatSettingsRequest
+	(*SetChatSettingsResponse)(nil),          // 109: jungletv.SetChatSettingsResponse
+	(*BanUserRequest)(nil),                   // 110: jungletv.BanUserRequest
+	(*BanUserResponse)(nil),                  // 111: jungletv.BanUserResponse
+	(*RemoveBanRequest)(nil),                 // 112: jungletv.RemoveBanRequest
+	(*RemoveBanResponse)(nil),                // 113: jungletv.RemoveBanResponse
+	(*SetVideoEnqueuingEnabledRequest)(nil),  // 114: jungletv.SetVideoEnqueuingEnabledRequest
+	(*SetVideoEnqueuingEnabledResponse)(nil), // 115: jungletv.SetVideoEnqueuingEnabledResponse
+	(*UserChatMessagesRequest)(nil),          // 116: jungletv.UserChatMessagesRequest
+	(*UserChatMessagesResponse)(nil),         // 117: jungletv.UserChatMessagesResponse
+	(*SubmitProofOfWorkRequest)(nil),         // 118: jungletv.SubmitProofOfWorkRequest
+	(*SubmitProofOfWorkResponse)(nil),        // 119: jungletv.SubmitProofOfWorkResponse
+	(*UserPermissionLevelRequest)(nil),       // 120: jungletv.UserPermissionLevelRequest
+	(*UserPermissionLevelResponse)(nil),      // 121: jungletv.UserPermissionLevelResponse
+	(*PlayedMediaHistoryRequest)(nil),        // 122: jungletv.PlayedMediaHistoryRequest
+	(*PlayedMedia)(nil),                      // 123: jungletv.PlayedMedia
+	(*PlayedMediaHistoryResponse)(nil),       // 124: jungletv.PlayedMediaHistoryResponse
+	(*PrepaidBalanceStatementRequest)(nil),   // 125: jungletv.PrepaidBalanceStatementRequest
+	(*PrepaidBalanceStatementResponse)(nil),  // 126: jungletv.PrepaidBalanceStatementResponse
+	(*PrepaidBalanceLedgerEntry)(nil),        // 127: jungletv.PrepaidBalanceLedgerEntry
+	(*WithdrawPrepaidBalanceRequest)(nil),    // 128: jungletv.WithdrawPrepaidBalanceRequest
+	(*WithdrawPrepaidBalanceResponse)(nil),   // 129: jungletv.WithdrawPrepaidBalanceResponse
+	(*ChannelsRequest)(nil),                  // 130: jungletv.ChannelsRequest
+	(*Channel)(nil),                          // 131: jungletv.Channel
+	(*ChannelsResponse)(nil),                 // 132: jungletv.ChannelsResponse
+	(*timestamppb.Timestamp)(nil),            // 133: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 134: google.protobuf.Duration
+}
+var file_jungletv_proto_depIdxs = []int32{
+	12,  // 0: jungletv.SignInProgress.verification:type_name -> jungletv.SignInVerification
+	14,  // 1: jungletv.SignInProgress.response:type_name -> jungletv.SignInResponse
+	15,  // 2: jungletv.SignInProgress.expired:type_name -> jungletv.SignInVerificationExpired
+	13,  // 3: jungletv.SignInProgress.account_unopened:type_name -> jungletv.SignInAccountUnopened
+	133, // 4: jungletv.SignInVerification.expiration:type_name -> google.protobuf.Timestamp
+	133, // 5: jungletv.SignInResponse.token_expiration:type_name -> google.protobuf.Timestamp
+	134, // 6: jungletv.EnqueueYouTubeVideoData.start_offset:type_name -> google.protobuf.Duration
+	134, // 7: jungletv.EnqueueYouTubeVideoData.end_offset:type_name -> google.protobuf.Duration
+	134, // 8: jungletv.EnqueueDirectMediaData.duration:type_name -> google.protobuf.Duration
+	19,  // 9: jungletv.EnqueueMediaRequest.stub_data:type_name -> jungletv.EnqueueStubData
+	16,  // 10: jungletv.EnqueueMediaRequest.youtube_video_data:type_name -> jungletv.EnqueueYouTubeVideoData
+	17,  // 11: jungletv.EnqueueMediaRequest.direct_media_data:type_name -> jungletv.EnqueueDirectMediaData
+	18,  // 12: jungletv.EnqueueMediaRequest.youtube_playlist_data:type_name -> jungletv.EnqueueYouTubePlaylistData
+	5,   // 13: jungletv.EnqueueMediaRequest.balance_payment_tier:type_name -> jungletv.ForcedTicketEnqueueType
+	5,   // 14: jungletv.EnqueueMediaRequest.crowdfunding_target_tier:type_name -> jungletv.ForcedTicketEnqueueType
+	25,  // 15: jungletv.EnqueueMediaResponse.ticket:type_name -> jungletv.EnqueueMediaTicket
+	22,  // 16: jungletv.EnqueueMediaResponse.failure:type_name -> jungletv.EnqueueMediaFailure
+	24,  // 17: jungletv.GetEnqueuePricingResponse.quote:type_name -> jungletv.EnqueuePricingQuote
+	22,  // 18: jungletv.GetEnqueuePricingResponse.failure:type_name -> jungletv.EnqueueMediaFailure
+	133, // 19: jungletv.EnqueuePricingQuote.enqueue_projected_start:type_name -> google.protobuf.Timestamp
+	133, // 20: jungletv.EnqueuePricingQuote.play_next_projected_start:type_name -> google.protobuf.Timestamp
+	133, // 21: jungletv.EnqueuePricingQuote.play_now_projected_start:type_name -> google.protobuf.Timestamp
+	0,   // 22: jungletv.EnqueueMediaTicket.status:type_name -> jungletv.EnqueueMediaTicketStatus
+	133, // 23: jungletv.EnqueueMediaTicket.expiration:type_name -> google.protobuf.Timestamp
+	44,  // 24: jungletv.EnqueueMediaTicket.youtube_video_data:type_name -> jungletv.QueueYouTubeVideoData
+	47,  // 25: jungletv.EnqueueMediaTicket.direct_media_data:type_name -> jungletv.QueueDirectMediaData
+	45,  // 26: jungletv.EnqueueMediaTicket.youtube_playlist_data:type_name -> jungletv.QueueYouTubePlaylistData
+	133, // 27: jungletv.EnqueueMediaTicket.enqueue_projected_start:type_name -> google.protobuf.Timestamp
+	133, // 28: jungletv.EnqueueMediaTicket.play_next_projected_start:type_name -> google.protobuf.Timestamp
+	133, // 29: jungletv.EnqueueMediaTicket.play_now_projected_start:type_name -> google.protobuf.Timestamp
+	5,   // 30: jungletv.EnqueueMediaTicket.crowdfunding_target_tier:type_name -> jungletv.ForcedTicketEnqueueType
+	134, // 31: jungletv.NowPlayingYouTubeVideoData.start_offset:type_name -> google.protobuf.Duration
+	134, // 32: jungletv.NowPlayingYouTubeVideoData.end_offset:type_name -> google.protobuf.Duration
+	134, // 33: jungletv.MediaConsumptionCheckpoint.current_position:type_name -> google.protobuf.Duration
+	50,  // 34: jungletv.MediaConsumptionCheckpoint.requested_by:type_name -> jungletv.User
+	32,  // 35: jungletv.MediaConsumptionCheckpoint.activity_challenge:type_name -> jungletv.ActivityChallenge
+	33,  // 36: jungletv.MediaConsumptionCheckpoint.pow_task:type_name -> jungletv.ProofOfWorkTask
+	28,  // 37: jungletv.MediaConsumptionCheckpoint.stub_data:type_name -> jungletv.NowPlayingStubData
+	29,  // 38: jungletv.MediaConsumptionCheckpoint.youtube_video_data:type_name -> jungletv.NowPlayingYouTubeVideoData
+	30,  // 39: jungletv.MediaConsumptionCheckpoint.direct_media_data:type_name -> jungletv.NowPlayingDirectMediaData
+	48,  // 40: jungletv.Queue.entries:type_name -> jungletv.QueueEntry
+	43,  // 41: jungletv.Queue.scheduled_media:type_name -> jungletv.ScheduledMedia
+	36,  // 42: jungletv.Queue.changes:type_name -> jungletv.QueueChanges
+	37,  // 43: jungletv.QueueChanges.changes:type_name -> jungletv.QueueChange
+	133, // 44: jungletv.QueueChanges.projected_starts:type_name -> google.protobuf.Timestamp
+	43,  // 45: jungletv.QueueChanges.scheduled_media:type_name -> jungletv.ScheduledMedia
+	38,  // 46: jungletv.QueueChange.inserted:type_name -> jungletv.QueueEntryInserted
+	39,  // 47: jungletv.QueueChange.removed:type_name -> jungletv.QueueEntryRemoved
+	40,  // 48: jungletv.QueueChange.moved:type_name -> jungletv.QueueEntryMoved
+	41,  // 49: jungletv.QueueChange.head_advanced:type_name -> jungletv.QueueHeadAdvanced
+	42,  // 50: jungletv.QueueChange.updated:type_name -> jungletv.QueueEntryUpdated
+	48,  // 51: jungletv.QueueEntryInserted.entry:type_name -> jungletv.QueueEntry
+	48,  // 52: jungletv.QueueEntryUpdated.entry:type_name -> jungletv.QueueEntry
+	133, // 53: jungletv.ScheduledMedia.starts_at:type_name -> google.protobuf.Timestamp
+	48,  // 54: jungletv.ScheduledMedia.entries:type_name -> jungletv.QueueEntry
+	134, // 55: jungletv.QueueYouTubeVideoData.start_offset:type_name -> google.protobuf.Duration
+	134, // 56: jungletv.QueueYouTubeVideoData.end_offset:type_name -> google.protobuf.Duration
+	44,  // 57: jungletv.QueueYouTubePlaylistData.items:type_name -> jungletv.QueueYouTubeVideoData
+	46,  // 58: jungletv.QueueYouTubePlaylistData.skipped_items:type_name -> jungletv.SkippedPlaylistItem
+	50,  // 59: jungletv.QueueEntry.requested_by:type_name -> jungletv.User
+	134, // 60: jungletv.QueueEntry.length:type_name -> google.protobuf.Duration
+	44,  // 61: jungletv.QueueEntry.youtube_video_data:type_name -> jungletv.QueueYouTubeVideoData
+	47,  // 62: jungletv.QueueEntry.direct_media_data:type_name -> jungletv.QueueDirectMediaData
+	133, // 63: jungletv.QueueEntry.projected_start:type_name -> google.protobuf.Timestamp
+	49,  // 64: jungletv.QueueEntry.contributions:type_name -> jungletv.QueueEntryContribution
+	50,  // 65: jungletv.QueueEntryContribution.contributor:type_name -> jungletv.User
+	1,   // 66: jungletv.User.roles:type_name -> jungletv.UserRole
+	48,  // 67: jungletv.ReceivedReward.entry:type_name -> jungletv.QueueEntry
+	133, // 68: jungletv.ReceivedReward.distributed_at:type_name -> google.protobuf.Timestamp
+	2,   // 69: jungletv.ReceivedReward.status:type_name -> jungletv.RewardDistributionStatus
+	54,  // 70: jungletv.RewardHistoryResponse.rewards:type_name -> jungletv.ReceivedReward
+	48,  // 71: jungletv.RewardDistribution.entry:type_name -> jungletv.QueueEntry
+	133, // 72: jungletv.RewardDistribution.distributed_at:type_name -> google.protobuf.Timestamp
+	2,   // 73: jungletv.RewardDistribution.status:type_name -> jungletv.RewardDistributionStatus
+	57,  // 74: jungletv.RewardDistribution.recipients:type_name -> jungletv.RewardRecipient
+	58,  // 75: jungletv.RewardDistributionsResponse.distributions:type_name -> jungletv.RewardDistribution
+	3,   // 76: jungletv.MoveQueueEntryRequest.movement:type_name -> jungletv.QueueEntryMovement
+	20,  // 77: jungletv.ScheduleMediaRequest.media:type_name -> jungletv.EnqueueMediaRequest
+	133, // 78: jungletv.ScheduleMediaRequest.starts_at:type_name -> google.protobuf.Timestamp
+	134, // 79: jungletv.AutoEnqueueRotation.start:type_name -> google.protobuf.Duration
+	134, // 80: jungletv.AutoEnqueueRotation.end:type_name -> google.protobuf.Duration
+	70,  // 81: jungletv.AutoEnqueueListResponse.items:type_name -> jungletv.AutoEnqueueItem
+	71,  // 82: jungletv.AutoEnqueueListResponse.rotations:type_name -> jungletv.AutoEnqueueRotation
+	70,  // 83: jungletv.SetAutoEnqueueListRequest.items:type_name -> jungletv.AutoEnqueueItem
+	71,  // 84: jungletv.SetAutoEnqueueListRequest.rotations:type_name -> jungletv.AutoEnqueueRotation
+	4,   // 85: jungletv.Voucher.type:type_name -> jungletv.VoucherType
+	134, // 86: jungletv.Voucher.free_enqueue_max_length:type_name -> google.protobuf.Duration
+	133, // 87: jungletv.Voucher.expiration:type_name -> google.protobuf.Timestamp
+	133, // 88: jungletv.Voucher.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 89: jungletv.MintVoucherRequest.type:type_name -> jungletv.VoucherType
+	134, // 90: jungletv.MintVoucherRequest.free_enqueue_max_length:type_name -> google.protobuf.Duration
+	133, // 91: jungletv.MintVoucherRequest.expiration:type_name -> google.protobuf.Timestamp
+	80,  // 92: jungletv.MintVoucherResponse.voucher:type_name -> jungletv.Voucher
+	80,  // 93: jungletv.VouchersResponse.vouchers:type_name -> jungletv.Voucher
+	133, // 94: jungletv.VoucherRedemption.redeemed_at:type_name -> google.protobuf.Timestamp
+	50,  // 95: jungletv.VoucherRedemption.redeemed_by:type_name -> jungletv.User
+	88,  // 96: jungletv.VoucherRedemptionsResponse.redemptions:type_name -> jungletv.VoucherRedemption
+	5,   // 97: jungletv.ForciblyEnqueueTicketRequest.enqueue_type:type_name -> jungletv.ForcedTicketEnqueueType
+	99,  // 98: jungletv.ChatUpdate.disabled:type_name -> jungletv.ChatDisabledEvent
+	100, // 99: jungletv.ChatUpdate.enabled:type_name -> jungletv.ChatEnabledEvent
+	101, // 100: jungletv.ChatUpdate.message_created:type_name -> jungletv.ChatMessageCreatedEvent
+	102, // 101: jungletv.ChatUpdate.message_deleted:type_name -> jungletv.ChatMessageDeletedEvent
+	103, // 102: jungletv.ChatUpdate.heartbeat:type_name -> jungletv.ChatHeartbeatEvent
+	133, // 103: jungletv.ChatMessage.created_at:type_name -> google.protobuf.Timestamp
+	97,  // 104: jungletv.ChatMessage.user_message:type_name -> jungletv.UserChatMessage
+	98,  // 105: jungletv.ChatMessage.system_message:type_name -> jungletv.SystemChatMessage
+	96,  // 106: jungletv.ChatMessage.reference:type_name -> jungletv.ChatMessage
+	50,  // 107: jungletv.UserChatMessage.author:type_name -> jungletv.User
+	6,   // 108: jungletv.ChatDisabledEvent.reason:type_name -> jungletv.ChatDisabledReason
+	96,  // 109: jungletv.ChatMessageCreatedEvent.message:type_name -> jungletv.ChatMessage
+	7,   // 110: jungletv.SetVideoEnqueuingEnabledRequest.allowed:type_name -> jungletv.AllowedVideoEnqueuingType
+	96,  // 111: jungletv.UserChatMessagesResponse.messages:type_name -> jungletv.ChatMessage
+	8,   // 112: jungletv.UserPermissionLevelResponse.permission_level:type_name -> jungletv.PermissionLevel
+	48,  // 113: jungletv.PlayedMedia.entry:type_name -> jungletv.QueueEntry
+	133, // 114: jungletv.PlayedMedia.started_at:type_name -> google.protobuf.Timestamp
+	133, // 115: jungletv.PlayedMedia.ended_at:type_name -> google.protobuf.Timestamp
+	134, // 116: jungletv.PlayedMedia.played_for:type_name -> google.protobuf.Duration
+	123, // 117: jungletv.PlayedMediaHistoryResponse.played_media:type_name -> jungletv.PlayedMedia
+	127, // 118: jungletv.PrepaidBalanceStatementResponse.entries:type_name -> jungletv.PrepaidBalanceLedgerEntry
+	133, // 119: jungletv.PrepaidBalanceLedgerEntry.at:type_name -> google.protobuf.Timestamp
+	9,   // 120: jungletv.PrepaidBalanceLedgerEntry.type:type_name -> jungletv.PrepaidBalanceLedgerEntryType
+	131, // 121: jungletv.ChannelsResponse.channels:type_name -> jungletv.Channel
+	10,  // 122: jungletv.JungleTV.SignIn:input_type -> jungletv.SignInRequest
+	20,  // 123: jungletv.JungleTV.EnqueueMedia:input_type -> jungletv.EnqueueMediaRequest
+	20,  // 124: jungletv.JungleTV.GetEnqueuePricing:input_type -> jungletv.EnqueueMediaRequest
+	26,  // 125: jungletv.JungleTV.MonitorTicket:input_type -> jungletv.MonitorTicketRequest
+	27,  // 126: jungletv.JungleTV.ConsumeMedia:input_type -> jungletv.ConsumeMediaRequest
+	34,  // 127: jungletv.JungleTV.MonitorQueue:input_type -> jungletv.MonitorQueueRequest
+	51,  // 128: jungletv.JungleTV.RewardInfo:input_type -> jungletv.RewardInfoRequest
+	92,  // 129: jungletv.JungleTV.SubmitActivityChallenge:input_type -> jungletv.SubmitActivityChallengeRequest
+	94,  // 130: jungletv.JungleTV.ConsumeChat:input_type -> jungletv.ConsumeChatRequest
+	104, // 131: jungletv.JungleTV.SendChatMessage:input_type -> jungletv.SendChatMessageRequest
+	118, // 132: jungletv.JungleTV.SubmitProofOfWork:input_type -> jungletv.SubmitProofOfWorkRequest
+	120, // 133: jungletv.JungleTV.UserPermissionLevel:input_type -> jungletv.UserPermissionLevelRequest
+	122, // 134: jungletv.JungleTV.PlayedMediaHistory:input_type -> jungletv.PlayedMediaHistoryRequest
+	130, // 135: jungletv.JungleTV.Channels:input_type -> jungletv.ChannelsRequest
+	125, // 136: jungletv.JungleTV.PrepaidBalanceStatement:input_type -> jungletv.PrepaidBalanceStatementRequest
+	128, // 137: jungletv.JungleTV.WithdrawPrepaidBalance:input_type -> jungletv.WithdrawPrepaidBalanceRequest
+	53,  // 138: jungletv.JungleTV.RewardHistory:input_type -> jungletv.RewardHistoryRequest
+	90,  // 139: jungletv.JungleTV.ForciblyEnqueueTicket:input_type -> jungletv.ForciblyEnqueueTicketRequest
+	60,  // 140: jungletv.JungleTV.RemoveQueueEntry:input_type -> jungletv.RemoveQueueEntryRequest
+	62,  // 141: jungletv.JungleTV.MoveQueueEntry:input_type -> jungletv.MoveQueueEntryRequest
+	64,  // 142: jungletv.JungleTV.SetPlaybackPaused:input_type -> jungletv.SetPlaybackPausedRequest
+	66,  // 143: jungletv.JungleTV.ScheduleMedia:input_type -> jungletv.ScheduleMediaRequest
+	68,  // 144: jungletv.JungleTV.UnscheduleMedia:input_type -> jungletv.UnscheduleMediaRequest
+	72,  // 145: jungletv.JungleTV.AutoEnqueueList:input_type -> jungletv.AutoEnqueueListRequest
+	74,  // 146: jungletv.JungleTV.SetAutoEnqueueList:input_type -> jungletv.SetAutoEnqueueListRequest
+	76,  // 147: jungletv.JungleTV.PricingStrategy:input_type -> jungletv.PricingStrategyRequest
+	78,  // 148: jungletv.JungleTV.SetPricingStrategy:input_type -> jungletv.SetPricingStrategyRequest
+	81,  // 149: jungletv.JungleTV.MintVoucher:input_type -> jungletv.MintVoucherRequest
+	83,  // 150: jungletv.JungleTV.Vouchers:input_type -> jungletv.VouchersRequest
+	85,  // 151: jungletv.JungleTV.RevokeVoucher:input_type -> jungletv.RevokeVoucherRequest
+	87,  // 152: jungletv.JungleTV.VoucherRedemptions:input_type -> jungletv.VoucherRedemptionsRequest
+	56,  // 153: jungletv.JungleTV.RewardDistributions:input_type -> jungletv.RewardDistributionsRequest
+	106, // 154: jungletv.JungleTV.RemoveChatMessage:input_type -> jungletv.RemoveChatMessageRequest
+	108, // 155: jungletv.JungleTV.SetChatSettings:input_type -> jungletv.SetChatSettingsRequest
+	114, // 156: jungletv.JungleTV.SetVideoEnqueuingEnabled:input_type -> jungletv.SetVideoEnqueuingEnabledRequest
+	110, // 157: jungletv.JungleTV.BanUser:input_type -> jungletv.BanUserRequest
+	112, // 158: jungletv.JungleTV.RemoveBan:input_type -> jungletv.RemoveBanRequest
+	116, // 159: jungletv.JungleTV.UserChatMessages:input_type -> jungletv.UserChatMessagesRequest
+	11,  // 160: jungletv.JungleTV.SignIn:output_type -> jungletv.SignInProgress
+	21,  // 161: jungletv.JungleTV.EnqueueMedia:output_type -> jungletv.EnqueueMediaResponse
+	23,  // 162: jungletv.JungleTV.GetEnqueuePricing:output_type -> jungletv.GetEnqueuePricingResponse
+	25,  // 163: jungletv.JungleTV.MonitorTicket:output_type -> jungletv.EnqueueMediaTicket
+	31,  // 164: jungletv.JungleTV.ConsumeMedia:output_type -> jungletv.MediaConsumptionCheckpoint
+	35,  // 165: jungletv.JungleTV.MonitorQueue:output_type -> jungletv.Queue
+	52,  // 166: jungletv.JungleTV.RewardInfo:output_type -> jungletv.RewardInfoResponse
+	93,  // 167: jungletv.JungleTV.SubmitActivityChallenge:output_type -> jungletv.SubmitActivityChallengeResponse
+	95,  // 168: jungletv.JungleTV.ConsumeChat:output_type -> jungletv.ChatUpdate
+	105, // 169: jungletv.JungleTV.SendChatMessage:output_type -> jungletv.SendChatMessageResponse
+	119, // 170: jungletv.JungleTV.SubmitProofOfWork:output_type -> jungletv.SubmitProofOfWorkResponse
+	121, // 171: jungletv.JungleTV.UserPermissionLevel:output_type -> jungletv.UserPermissionLevelResponse
+	124, // 172: jungletv.JungleTV.PlayedMediaHistory:output_type -> jungletv.PlayedMediaHistoryResponse
+	132, // 173: jungletv.JungleTV.Channels:output_type -> jungletv.ChannelsResponse
+	126, // 174: jungletv.JungleTV.PrepaidBalanceStatement:output_type -> jungletv.PrepaidBalanceStatementResponse
+	129, // 175: jungletv.JungleTV.WithdrawPrepaidBalance:output_type -> jungletv.WithdrawPrepaidBalanceResponse
+	55,  // 176: jungletv.JungleTV.RewardHistory:output_type -> jungletv.RewardHistoryResponse
+	91,  // 177: jungletv.JungleTV.ForciblyEnqueueTicket:output_type -> jungletv.ForciblyEnqueueTicketResponse
+	61,  // 178: jungletv.JungleTV.RemoveQueueEntry:output_type -> jungletv.RemoveQueueEntryResponse
+	63,  // 179: jungletv.JungleTV.MoveQueueEntry:output_type -> jungletv.MoveQueueEntryResponse
+	65,  // 180: jungletv.JungleTV.SetPlaybackPaused:output_type -> jungletv.SetPlaybackPausedResponse
+	67,  // 181: jungletv.JungleTV.ScheduleMedia:output_type -> jungletv.ScheduleMediaResponse
+	69,  // 182: jungletv.JungleTV.UnscheduleMedia:output_type -> jungletv.UnscheduleMediaResponse
+	73,  // 183: jungletv.JungleTV.AutoEnqueueList:output_type -> jungletv.AutoEnqueueListResponse
+	75,  // 184: jungletv.JungleTV.SetAutoEnqueueList:output_type -> jungletv.SetAutoEnqueueListResponse
+	77,  // 185: jungletv.JungleTV.PricingStrategy:output_type -> jungletv.PricingStrategyResponse
+	79,  // 186: jungletv.JungleTV.SetPricingStrategy:output_type -> jungletv.SetPricingStrategyResponse
+	82,  // 187: jungletv.JungleTV.MintVoucher:output_type -> jungletv.MintVoucherResponse
+	84,  // 188: jungletv.JungleTV.Vouchers:output_type -> jungletv.VouchersResponse
+	86,  // 189: jungletv.JungleTV.RevokeVoucher:output_type -> jungletv.RevokeVoucherResponse
+	89,  // 190: jungletv.JungleTV.VoucherRedemptions:output_type -> jungletv.VoucherRedemptionsResponse
+	59,  // 191: jungletv.JungleTV.RewardDistributions:output_type -> jungletv.RewardDistributionsResponse
+	107, // 192: jungletv.JungleTV.RemoveChatMessage:output_type -> jungletv.RemoveChatMessageResponse
+	109, // 193: jungletv.JungleTV.SetChatSettings:output_type -> jungletv.SetChatSettingsResponse
+	115, // 194: jungletv.JungleTV.SetVideoEnqueuingEnabled:output_type -> jungletv.SetVideoEnqueuingEnabledResponse
+	111, // 195: jungletv.JungleTV.BanUser:output_type -> jungletv.BanUserResponse
+	113, // 196: jungletv.JungleTV.RemoveBan:output_type -> jungletv.RemoveBanResponse
+	117, // 197: jungletv.JungleTV.UserChatMessages:output_type -> jungletv.UserChatMessagesResponse
+	160, // [160:198] is the sub-list for method output_type
+	122, // [122:160] is the sub-list for method input_type
+	122, // [122:122] is the sub-list for extension type_name
+	122, // [122:122] is the sub-list for extension extendee
+	0,   // [0:122] is the sub-list for field type_name
+}
+
+func init() { file_jungletv_proto_init() }
+func file_jungletv_proto_init() {
+	if File_jungletv_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_jungletv_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInAccountUnopened); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInVerificationExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueYouTubeVideoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueDirectMediaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueYouTubePlaylistData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueStubData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueMediaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueMediaFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnqueuePricingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueuePricingQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueMediaTicket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorTicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumeMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NowPlayingStubData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NowPlayingYouTubeVideoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NowPlayingDirectMediaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaConsumptionCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofOfWorkTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Queue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntryInserted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntryRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntryMoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueHeadAdvanced); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntryUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueYouTubeVideoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueYouTubePlaylistData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkippedPlaylistItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueDirectMediaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntryContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDistributionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDistribution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4412,8 +10655,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInProgress); i {
+		file_jungletv_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDistributionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4424,8 +10667,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInVerification); i {
+		file_jungletv_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveQueueEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4436,8 +10679,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInAccountUnopened); i {
+		file_jungletv_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveQueueEntryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4448,8 +10691,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInResponse); i {
+		file_jungletv_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveQueueEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4460,8 +10703,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInVerificationExpired); i {
+		file_jungletv_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveQueueEntryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4472,8 +10715,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueYouTubeVideoData); i {
+		file_jungletv_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlaybackPausedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4484,8 +10727,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueStubData); i {
+		file_jungletv_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlaybackPausedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4496,8 +10739,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueMediaRequest); i {
+		file_jungletv_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMediaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4508,8 +10751,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueMediaResponse); i {
+		file_jungletv_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMediaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4520,8 +10763,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueMediaFailure); i {
+		file_jungletv_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnscheduleMediaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4532,8 +10775,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueMediaTicket); i {
+		file_jungletv_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnscheduleMediaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4544,8 +10787,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorTicketRequest); i {
+		file_jungletv_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoEnqueueItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4556,8 +10799,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsumeMediaRequest); i {
+		file_jungletv_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoEnqueueRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4568,8 +10811,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NowPlayingStubData); i {
+		file_jungletv_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoEnqueueListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4580,8 +10823,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NowPlayingYouTubeVideoData); i {
+		file_jungletv_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoEnqueueListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4592,8 +10835,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediaConsumptionCheckpoint); i {
+		file_jungletv_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoEnqueueListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4604,8 +10847,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityChallenge); i {
+		file_jungletv_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoEnqueueListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4616,8 +10859,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofOfWorkTask); i {
+		file_jungletv_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PricingStrategyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4628,8 +10871,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorQueueRequest); i {
+		file_jungletv_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PricingStrategyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4640,8 +10883,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Queue); i {
+		file_jungletv_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPricingStrategyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4652,8 +10895,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueYouTubeVideoData); i {
+		file_jungletv_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPricingStrategyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4664,8 +10907,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueEntry); i {
+		file_jungletv_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Voucher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4676,8 +10919,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_jungletv_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintVoucherRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4688,8 +10931,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardInfoRequest); i {
+		file_jungletv_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintVoucherResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4700,8 +10943,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardInfoResponse); i {
+		file_jungletv_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VouchersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4712,8 +10955,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveQueueEntryRequest); i {
+		file_jungletv_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VouchersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4724,8 +10967,8 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveQueueEntryResponse); i {
+		file_jungletv_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeVoucherRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4736,7 +10979,55 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeVoucherResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoucherRedemptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoucherRedemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoucherRedemptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForciblyEnqueueTicketRequest); i {
 			case 0:
 				return &v.state
@@ -4748,7 +11039,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForciblyEnqueueTicketResponse); i {
 			case 0:
 				return &v.state
@@ -4760,7 +11051,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitActivityChallengeRequest); i {
 			case 0:
 				return &v.state
@@ -4772,7 +11063,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitActivityChallengeResponse); i {
 			case 0:
 				return &v.state
@@ -4784,7 +11075,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumeChatRequest); i {
 			case 0:
 				return &v.state
@@ -4796,7 +11087,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatUpdate); i {
 			case 0:
 				return &v.state
@@ -4808,7 +11099,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatMessage); i {
 			case 0:
 				return &v.state
@@ -4820,7 +11111,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserChatMessage); i {
 			case 0:
 				return &v.state
@@ -4832,7 +11123,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemChatMessage); i {
 			case 0:
 				return &v.state
@@ -4844,7 +11135,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatDisabledEvent); i {
 			case 0:
 				return &v.state
@@ -4856,7 +11147,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatEnabledEvent); i {
 			case 0:
 				return &v.state
@@ -4868,7 +11159,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatMessageCreatedEvent); i {
 			case 0:
 				return &v.state
@@ -4880,7 +11171,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatMessageDeletedEvent); i {
 			case 0:
 				return &v.state
@@ -4892,7 +11183,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatHeartbeatEvent); i {
 			case 0:
 				return &v.state
@@ -4904,7 +11195,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendChatMessageRequest); i {
 			case 0:
 				return &v.state
@@ -4916,7 +11207,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendChatMessageResponse); i {
 			case 0:
 				return &v.state
@@ -4928,7 +11219,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveChatMessageRequest); i {
 			case 0:
 				return &v.state
@@ -4940,7 +11231,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveChatMessageResponse); i {
 			case 0:
 				return &v.state
@@ -4952,7 +11243,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetChatSettingsRequest); i {
 			case 0:
 				return &v.state
@@ -4964,7 +11255,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetChatSettingsResponse); i {
 			case 0:
 				return &v.state
@@ -4976,7 +11267,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BanUserRequest); i {
 			case 0:
 				return &v.state
@@ -4988,7 +11279,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BanUserResponse); i {
 			case 0:
 				return &v.state
@@ -5000,7 +11291,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveBanRequest); i {
 			case 0:
 				return &v.state
@@ -5012,7 +11303,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveBanResponse); i {
 			case 0:
 				return &v.state
@@ -5024,7 +11315,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetVideoEnqueuingEnabledRequest); i {
 			case 0:
 				return &v.state
@@ -5036,7 +11327,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetVideoEnqueuingEnabledResponse); i {
 			case 0:
 				return &v.state
@@ -5048,7 +11339,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserChatMessagesRequest); i {
 			case 0:
 				return &v.state
@@ -5060,7 +11351,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserChatMessagesResponse); i {
 			case 0:
 				return &v.state
@@ -5072,7 +11363,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitProofOfWorkRequest); i {
 			case 0:
 				return &v.state
@@ -5084,7 +11375,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitProofOfWorkResponse); i {
 			case 0:
 				return &v.state
@@ -5096,7 +11387,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserPermissionLevelRequest); i {
 			case 0:
 				return &v.state
@@ -5108,7 +11399,7 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
-		file_jungletv_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_jungletv_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserPermissionLevelResponse); i {
 			case 0:
 				return &v.state
@@ -5120,6 +11411,138 @@ func file_jungletv_proto_init() {
 				return nil
 			}
 		}
+		file_jungletv_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayedMediaHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayedMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayedMediaHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepaidBalanceStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepaidBalanceStatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepaidBalanceLedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawPrepaidBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawPrepaidBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jungletv_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_jungletv_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*SignInProgress_Verification)(nil),
@@ -5127,43 +11550,62 @@ func file_jungletv_proto_init() {
 		(*SignInProgress_Expired)(nil),
 		(*SignInProgress_AccountUnopened)(nil),
 	}
-	file_jungletv_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*EnqueueMediaRequest_StubData)(nil),
 		(*EnqueueMediaRequest_YoutubeVideoData)(nil),
+		(*EnqueueMediaRequest_DirectMediaData)(nil),
+		(*EnqueueMediaRequest_YoutubePlaylistData)(nil),
 	}
-	file_jungletv_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*EnqueueMediaResponse_Ticket)(nil),
 		(*EnqueueMediaResponse_Failure)(nil),
 	}
-	file_jungletv_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*GetEnqueuePricingResponse_Quote)(nil),
+		(*GetEnqueuePricingResponse_Failure)(nil),
+	}
+	file_jungletv_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*EnqueueMediaTicket_YoutubeVideoData)(nil),
+		(*EnqueueMediaTicket_DirectMediaData)(nil),
+		(*EnqueueMediaTicket_YoutubePlaylistData)(nil),
 	}
-	file_jungletv_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*MediaConsumptionCheckpoint_StubData)(nil),
 		(*MediaConsumptionCheckpoint_YoutubeVideoData)(nil),
+		(*MediaConsumptionCheckpoint_DirectMediaData)(nil),
+	}
+	file_jungletv_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*QueueChange_Inserted)(nil),
+		(*QueueChange_Removed)(nil),
+		(*QueueChange_Moved)(nil),
+		(*QueueChange_HeadAdvanced)(nil),
+		(*QueueChange_Updated)(nil),
 	}
-	file_jungletv_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*QueueEntry_YoutubeVideoData)(nil),
+		(*QueueEntry_DirectMediaData)(nil),
 	}
-	file_jungletv_proto_msgTypes[33].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_jungletv_proto_msgTypes[71].OneofWrappers = []interface{}{}
+	file_jungletv_proto_msgTypes[85].OneofWrappers = []interface{}{
 		(*ChatUpdate_Disabled)(nil),
 		(*ChatUpdate_Enabled)(nil),
 		(*ChatUpdate_MessageCreated)(nil),
 		(*ChatUpdate_MessageDeleted)(nil),
 		(*ChatUpdate_Heartbeat)(nil),
 	}
-	file_jungletv_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_jungletv_proto_msgTypes[86].OneofWrappers = []interface{}{
 		(*ChatMessage_UserMessage)(nil),
 		(*ChatMessage_SystemMessage)(nil),
 	}
-	file_jungletv_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_jungletv_proto_msgTypes[94].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_jungletv_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   60,
+			NumEnums:      10,
+			NumMessages:   123,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,6 +19,7 @@ const _ = grpc.SupportPackageIsVersion7
 type JungleTVClient interface {
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (JungleTV_SignInClient, error)
 	EnqueueMedia(ctx context.Context, in *EnqueueMediaRequest, opts ...grpc.CallOption) (*EnqueueMediaResponse, error)
+	GetEnqueuePricing(ctx context.Context, in *EnqueueMediaRequest, opts ...grpc.CallOption) (*GetEnqueuePricingResponse, error)
 	MonitorTicket(ctx context.Context, in *MonitorTicketRequest, opts ...grpc.CallOption) (JungleTV_MonitorTicketClient, error)
 	ConsumeMedia(ctx context.Context, in *ConsumeMediaRequest, opts ...grpc.CallOption) (JungleTV_ConsumeMediaClient, error)
 	MonitorQueue(ctx context.Context, in *MonitorQueueRequest, opts ...grpc.CallOption) (JungleTV_MonitorQueueClient, error)
@@ -28,9 +29,27 @@ type JungleTVClient interface {
 	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
 	SubmitProofOfWork(ctx context.Context, in *SubmitProofOfWorkRequest, opts ...grpc.CallOption) (*SubmitProofOfWorkResponse, error)
 	UserPermissionLevel(ctx context.Context, in *UserPermissionLevelRequest, opts ...grpc.CallOption) (*UserPermissionLevelResponse, error)
+	PlayedMediaHistory(ctx context.Context, in *PlayedMediaHistoryRequest, opts ...grpc.CallOption) (*PlayedMediaHistoryResponse, error)
+	Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error)
+	PrepaidBalanceStatement(ctx context.Context, in *PrepaidBalanceStatementRequest, opts ...grpc.CallOption) (*PrepaidBalanceStatementResponse, error)
+	WithdrawPrepaidBalance(ctx context.Context, in *WithdrawPrepaidBalanceRequest, opts ...grpc.CallOption) (*WithdrawPrepaidBalanceResponse, error)
+	RewardHistory(ctx context.Context, in *RewardHistoryRequest, opts ...grpc.CallOption) (*RewardHistoryResponse, error)
 	// moderation endpoints
 	ForciblyEnqueueTicket(ctx context.Context, in *ForciblyEnqueueTicketRequest, opts ...grpc.CallOption) (*ForciblyEnqueueTicketResponse, error)
 	RemoveQueueEntry(ctx context.Context, in *RemoveQueueEntryRequest, opts ...grpc.CallOption) (*RemoveQueueEntryResponse, error)
+	MoveQueueEntry(ctx context.Context, in *MoveQueueEntryRequest, opts ...grpc.CallOption) (*MoveQueueEntryResponse, error)
+	SetPlaybackPaused(ctx context.Context, in *SetPlaybackPausedRequest, opts ...grpc.CallOption) (*SetPlaybackPausedResponse, error)
+	ScheduleMedia(ctx context.Context, in *ScheduleMediaRequest, opts ...grpc.CallOption) (*ScheduleMediaResponse, error)
+	UnscheduleMedia(ctx context.Context, in *UnscheduleMediaRequest, opts ...grpc.CallOption) (*UnscheduleMediaResponse, error)
+	AutoEnqueueList(ctx context.Context, in *AutoEnqueueListRequest, opts ...grpc.CallOption) (*AutoEnqueueListResponse, error)
+	SetAutoEnqueueList(ctx context.Context, in *SetAutoEnqueueListRequest, opts ...grpc.CallOption) (*SetAutoEnqueueListResponse, error)
+	PricingStrategy(ctx context.Context, in *PricingStrategyRequest, opts ...grpc.CallOption) (*PricingStrategyResponse, error)
+	SetPricingStrategy(ctx context.Context, in *SetPricingStrategyRequest, opts ...grpc.CallOption) (*SetPricingStrategyResponse, error)
+	MintVoucher(ctx context.Context, in *MintVoucherRequest, opts ...grpc.CallOption) (*MintVoucherResponse, error)
+	Vouchers(ctx context.Context, in *VouchersRequest, opts ...grpc.CallOption) (*VouchersResponse, error)
+	RevokeVoucher(ctx context.Context, in *RevokeVoucherRequest, opts ...grpc.CallOption) (*RevokeVoucherResponse, error)
+	VoucherRedemptions(ctx context.Context, in *VoucherRedemptionsRequest, opts ...grpc.CallOption) (*VoucherRedemptionsResponse, error)
+	RewardDistributions(ctx context.Context, in *RewardDistributionsRequest, opts ...grpc.CallOption) (*RewardDistributionsResponse, error)
 	RemoveChatMessage(ctx context.Context, in *RemoveChatMessageRequest, opts ...grpc.CallOption) (*RemoveChatMessageResponse, error)
 	SetChatSettings(ctx context.Context, in *SetChatSettingsRequest, opts ...grpc.CallOption) (*SetChatSettingsResponse, error)
 	SetVideoEnqueuingEnabled(ctx context.Context, in *SetVideoEnqueuingEnabledRequest, opts ...grpc.CallOption) (*SetVideoEnqueuingEnabledResponse, error)
@@ -88,6 +107,15 @@ func (c *jungleTVClient) EnqueueMedia(ctx context.Context, in *EnqueueMediaReque
 	return out, nil
 }
 
+func (c *jungleTVClient) GetEnqueuePricing(ctx context.Context, in *EnqueueMediaRequest, opts ...grpc.CallOption) (*GetEnqueuePricingResponse, error) {
+	out := new(GetEnqueuePricingResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/GetEnqueuePricing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) MonitorTicket(ctx context.Context, in *MonitorTicketRequest, opts ...grpc.CallOption) (JungleTV_MonitorTicketClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_JungleTV_serviceDesc.Streams[1], "/jungletv.JungleTV/MonitorTicket", opts...)
 	if err != nil {
@@ -261,6 +289,51 @@ func (c *jungleTVClient) UserPermissionLevel(ctx context.Context, in *UserPermis
 	return out, nil
 }
 
+func (c *jungleTVClient) PlayedMediaHistory(ctx context.Context, in *PlayedMediaHistoryRequest, opts ...grpc.CallOption) (*PlayedMediaHistoryResponse, error) {
+	out := new(PlayedMediaHistoryResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PlayedMediaHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error) {
+	out := new(ChannelsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Channels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) PrepaidBalanceStatement(ctx context.Context, in *PrepaidBalanceStatementRequest, opts ...grpc.CallOption) (*PrepaidBalanceStatementResponse, error) {
+	out := new(PrepaidBalanceStatementResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PrepaidBalanceStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) WithdrawPrepaidBalance(ctx context.Context, in *WithdrawPrepaidBalanceRequest, opts ...grpc.CallOption) (*WithdrawPrepaidBalanceResponse, error) {
+	out := new(WithdrawPrepaidBalanceResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/WithdrawPrepaidBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RewardHistory(ctx context.Context, in *RewardHistoryRequest, opts ...grpc.CallOption) (*RewardHistoryResponse, error) {
+	out := new(RewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) ForciblyEnqueueTicket(ctx context.Context, in *ForciblyEnqueueTicketRequest, opts ...grpc.CallOption) (*ForciblyEnqueueTicketResponse, error) {
 	out := new(ForciblyEnqueueTicketResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ForciblyEnqueueTicket", in, out, opts...)
@@ -279,6 +352,123 @@ func (c *jungleTVClient) RemoveQueueEntry(ctx context.Context, in *RemoveQueueEn
 	return out, nil
 }
 
+func (c *jungleTVClient) MoveQueueEntry(ctx context.Context, in *MoveQueueEntryRequest, opts ...grpc.CallOption) (*MoveQueueEntryResponse, error) {
+	out := new(MoveQueueEntryResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/MoveQueueEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetPlaybackPaused(ctx context.Context, in *SetPlaybackPausedRequest, opts ...grpc.CallOption) (*SetPlaybackPausedResponse, error) {
+	out := new(SetPlaybackPausedResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetPlaybackPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ScheduleMedia(ctx context.Context, in *ScheduleMediaRequest, opts ...grpc.CallOption) (*ScheduleMediaResponse, error) {
+	out := new(ScheduleMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ScheduleMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UnscheduleMedia(ctx context.Context, in *UnscheduleMediaRequest, opts ...grpc.CallOption) (*UnscheduleMediaResponse, error) {
+	out := new(UnscheduleMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UnscheduleMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) AutoEnqueueList(ctx context.Context, in *AutoEnqueueListRequest, opts ...grpc.CallOption) (*AutoEnqueueListResponse, error) {
+	out := new(AutoEnqueueListResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AutoEnqueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetAutoEnqueueList(ctx context.Context, in *SetAutoEnqueueListRequest, opts ...grpc.CallOption) (*SetAutoEnqueueListResponse, error) {
+	out := new(SetAutoEnqueueListResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetAutoEnqueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) PricingStrategy(ctx context.Context, in *PricingStrategyRequest, opts ...grpc.CallOption) (*PricingStrategyResponse, error) {
+	out := new(PricingStrategyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PricingStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetPricingStrategy(ctx context.Context, in *SetPricingStrategyRequest, opts ...grpc.CallOption) (*SetPricingStrategyResponse, error) {
+	out := new(SetPricingStrategyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetPricingStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) MintVoucher(ctx context.Context, in *MintVoucherRequest, opts ...grpc.CallOption) (*MintVoucherResponse, error) {
+	out := new(MintVoucherResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/MintVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) Vouchers(ctx context.Context, in *VouchersRequest, opts ...grpc.CallOption) (*VouchersResponse, error) {
+	out := new(VouchersResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Vouchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RevokeVoucher(ctx context.Context, in *RevokeVoucherRequest, opts ...grpc.CallOption) (*RevokeVoucherResponse, error) {
+	out := new(RevokeVoucherResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RevokeVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) VoucherRedemptions(ctx context.Context, in *VoucherRedemptionsRequest, opts ...grpc.CallOption) (*VoucherRedemptionsResponse, error) {
+	out := new(VoucherRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/VoucherRedemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RewardDistributions(ctx context.Context, in *RewardDistributionsRequest, opts ...grpc.CallOption) (*RewardDistributionsResponse, error) {
+	out := new(RewardDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RewardDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) RemoveChatMessage(ctx context.Context, in *RemoveChatMessageRequest, opts ...grpc.CallOption) (*RemoveChatMessageResponse, error) {
 	out := new(RemoveChatMessageResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveChatMessage", in, out, opts...)
@@ -339,6 +529,7 @@ func (c *jungleTVClient) UserChatMessages(ctx context.Context, in *UserChatMessa
 type JungleTVServer interface {
 	SignIn(*SignInRequest, JungleTV_SignInServer) error
 	EnqueueMedia(context.Context, *EnqueueMediaRequest) (*EnqueueMediaResponse, error)
+	GetEnqueuePricing(context.Context, *EnqueueMediaRequest) (*GetEnqueuePricingResponse, error)
 	MonitorTicket(*MonitorTicketRequest, JungleTV_MonitorTicketServer) error
 	ConsumeMedia(*ConsumeMediaRequest, JungleTV_ConsumeMediaServer) error
 	MonitorQueue(*MonitorQueueRequest, JungleTV_MonitorQueueServer) error
@@ -348,9 +539,27 @@ type JungleTVServer interface {
 	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
 	SubmitProofOfWork(context.Context, *SubmitProofOfWorkRequest) (*SubmitProofOfWorkResponse, error)
 	UserPermissionLevel(context.Context, *UserPermissionLevelRequest) (*UserPermissionLevelResponse, error)
+	PlayedMediaHistory(context.Context, *PlayedMediaHistoryRequest) (*PlayedMediaHistoryResponse, error)
+	Channels(context.Context, *ChannelsRequest) (*ChannelsResponse, error)
+	PrepaidBalanceStatement(context.Context, *PrepaidBalanceStatementRequest) (*PrepaidBalanceStatementResponse, error)
+	WithdrawPrepaidBalance(context.Context, *WithdrawPrepaidBalanceRequest) (*WithdrawPrepaidBalanceResponse, error)
+	RewardHistory(context.Context, *RewardHistoryRequest) (*RewardHistoryResponse, error)
 	// moderation endpoints
 	ForciblyEnqueueTicket(context.Context, *ForciblyEnqueueTicketRequest) (*ForciblyEnqueueTicketResponse, error)
 	RemoveQueueEntry(context.Context, *RemoveQueueEntryRequest) (*RemoveQueueEntryResponse, error)
+	MoveQueueEntry(context.Context, *MoveQueueEntryRequest) (*MoveQueueEntryResponse, error)
+	SetPlaybackPaused(context.Context, *SetPlaybackPausedRequest) (*SetPlaybackPausedResponse, error)
+	ScheduleMedia(context.Context, *ScheduleMediaRequest) (*ScheduleMediaResponse, error)
+	UnscheduleMedia(context.Context, *UnscheduleMediaRequest) (*UnscheduleMediaResponse, error)
+	AutoEnqueueList(context.Context, *AutoEnqueueListRequest) (*AutoEnqueueListResponse, error)
+	SetAutoEnqueueList(context.Context, *SetAutoEnqueueListRequest) (*SetAutoEnqueueListResponse, error)
+	PricingStrategy(context.Context, *PricingStrategyRequest) (*PricingStrategyResponse, error)
+	SetPricingStrategy(context.Context, *SetPricingStrategyRequest) (*SetPricingStrategyResponse, error)
+	MintVoucher(context.Context, *MintVoucherRequest) (*MintVoucherResponse, error)
+	Vouchers(context.Context, *VouchersRequest) (*VouchersResponse, error)
+	RevokeVoucher(context.Context, *RevokeVoucherRequest) (*RevokeVoucherResponse, error)
+	VoucherRedemptions(context.Context, *VoucherRedemptionsRequest) (*VoucherRedemptionsResponse, error)
+	RewardDistributions(context.Context, *RewardDistributionsRequest) (*RewardDistributionsResponse, error)
 	RemoveChatMessage(context.Context, *RemoveChatMessageRequest) (*RemoveChatMessageResponse, error)
 	SetChatSettings(context.Context, *SetChatSettingsRequest) (*SetChatSettingsResponse, error)
 	SetVideoEnqueuingEnabled(context.Context, *SetVideoEnqueuingEnabledRequest) (*SetVideoEnqueuingEnabledResponse, error)
@@ -370,6 +579,9 @@ func (UnimplementedJungleTVServer) SignIn(*SignInRequest, JungleTV_SignInServer)
 func (UnimplementedJungleTVServer) EnqueueMedia(context.Context, *EnqueueMediaRequest) (*EnqueueMediaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnqueueMedia not implemented")
 }
+func (UnimplementedJungleTVServer) GetEnqueuePricing(context.Context, *EnqueueMediaRequest) (*GetEnqueuePricingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnqueuePricing not implemented")
+}
 func (UnimplementedJungleTVServer) MonitorTicket(*MonitorTicketRequest, JungleTV_MonitorTicketServer) error {
 	return status.Errorf(codes.Unimplemented, "method MonitorTicket not implemented")
 }
@@ -397,12 +609,66 @@ func (UnimplementedJungleTVServer) SubmitProofOfWork(context.Context, *SubmitPro
 func (UnimplementedJungleTVServer) UserPermissionLevel(context.Context, *UserPermissionLevelRequest) (*UserPermissionLevelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserPermissionLevel not implemented")
 }
+func (UnimplementedJungleTVServer) PlayedMediaHistory(context.Context, *PlayedMediaHistoryRequest) (*PlayedMediaHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayedMediaHistory not implemented")
+}
+func (UnimplementedJungleTVServer) Channels(context.Context, *ChannelsRequest) (*ChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Channels not implemented")
+}
+func (UnimplementedJungleTVServer) PrepaidBalanceStatement(context.Context, *PrepaidBalanceStatementRequest) (*PrepaidBalanceStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepaidBalanceStatement not implemented")
+}
+func (UnimplementedJungleTVServer) WithdrawPrepaidBalance(context.Context, *WithdrawPrepaidBalanceRequest) (*WithdrawPrepaidBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPrepaidBalance not implemented")
+}
+func (UnimplementedJungleTVServer) RewardHistory(context.Context, *RewardHistoryRequest) (*RewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
 func (UnimplementedJungleTVServer) ForciblyEnqueueTicket(context.Context, *ForciblyEnqueueTicketRequest) (*ForciblyEnqueueTicketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForciblyEnqueueTicket not implemented")
 }
 func (UnimplementedJungleTVServer) RemoveQueueEntry(context.Context, *RemoveQueueEntryRequest) (*RemoveQueueEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveQueueEntry not implemented")
 }
+func (UnimplementedJungleTVServer) MoveQueueEntry(context.Context, *MoveQueueEntryRequest) (*MoveQueueEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveQueueEntry not implemented")
+}
+func (UnimplementedJungleTVServer) SetPlaybackPaused(context.Context, *SetPlaybackPausedRequest) (*SetPlaybackPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlaybackPaused not implemented")
+}
+func (UnimplementedJungleTVServer) ScheduleMedia(context.Context, *ScheduleMediaRequest) (*ScheduleMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMedia not implemented")
+}
+func (UnimplementedJungleTVServer) UnscheduleMedia(context.Context, *UnscheduleMediaRequest) (*UnscheduleMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnscheduleMedia not implemented")
+}
+func (UnimplementedJungleTVServer) AutoEnqueueList(context.Context, *AutoEnqueueListRequest) (*AutoEnqueueListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoEnqueueList not implemented")
+}
+func (UnimplementedJungleTVServer) SetAutoEnqueueList(context.Context, *SetAutoEnqueueListRequest) (*SetAutoEnqueueListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoEnqueueList not implemented")
+}
+func (UnimplementedJungleTVServer) PricingStrategy(context.Context, *PricingStrategyRequest) (*PricingStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricingStrategy not implemented")
+}
+func (UnimplementedJungleTVServer) SetPricingStrategy(context.Context, *SetPricingStrategyRequest) (*SetPricingStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPricingStrategy not implemented")
+}
+func (UnimplementedJungleTVServer) MintVoucher(context.Context, *MintVoucherRequest) (*MintVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintVoucher not implemented")
+}
+func (UnimplementedJungleTVServer) Vouchers(context.Context, *VouchersRequest) (*VouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vouchers not implemented")
+}
+func (UnimplementedJungleTVServer) RevokeVoucher(context.Context, *RevokeVoucherRequest) (*RevokeVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoucher not implemented")
+}
+func (UnimplementedJungleTVServer) VoucherRedemptions(context.Context, *VoucherRedemptionsRequest) (*VoucherRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoucherRedemptions not implemented")
+}
+func (UnimplementedJungleTVServer) RewardDistributions(context.Context, *RewardDistributionsRequest) (*RewardDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDistributions not implemented")
+}
 func (UnimplementedJungleTVServer) RemoveChatMessage(context.Context, *RemoveChatMessageRequest) (*RemoveChatMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatMessage not implemented")
 }
@@ -473,6 +739,24 @@ func _JungleTV_EnqueueMedia_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_GetEnqueuePricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).GetEnqueuePricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/GetEnqueuePricing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).GetEnqueuePricing(ctx, req.(*EnqueueMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_MonitorTicket_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(MonitorTicketRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -647,6 +931,96 @@ func _JungleTV_UserPermissionLevel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_PlayedMediaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayedMediaHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PlayedMediaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PlayedMediaHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PlayedMediaHistory(ctx, req.(*PlayedMediaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_Channels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).Channels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/Channels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).Channels(ctx, req.(*ChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PrepaidBalanceStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepaidBalanceStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PrepaidBalanceStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PrepaidBalanceStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PrepaidBalanceStatement(ctx, req.(*PrepaidBalanceStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_WithdrawPrepaidBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawPrepaidBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).WithdrawPrepaidBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/WithdrawPrepaidBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).WithdrawPrepaidBalance(ctx, req.(*WithdrawPrepaidBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RewardHistory(ctx, req.(*RewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_ForciblyEnqueueTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForciblyEnqueueTicketRequest)
 	if err := dec(in); err != nil {
@@ -683,6 +1057,240 @@ func _JungleTV_RemoveQueueEntry_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_MoveQueueEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveQueueEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).MoveQueueEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/MoveQueueEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).MoveQueueEntry(ctx, req.(*MoveQueueEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetPlaybackPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlaybackPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetPlaybackPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetPlaybackPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetPlaybackPaused(ctx, req.(*SetPlaybackPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ScheduleMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ScheduleMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ScheduleMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ScheduleMedia(ctx, req.(*ScheduleMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UnscheduleMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnscheduleMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UnscheduleMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UnscheduleMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UnscheduleMedia(ctx, req.(*UnscheduleMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_AutoEnqueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoEnqueueListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AutoEnqueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AutoEnqueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AutoEnqueueList(ctx, req.(*AutoEnqueueListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetAutoEnqueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoEnqueueListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetAutoEnqueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetAutoEnqueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetAutoEnqueueList(ctx, req.(*SetAutoEnqueueListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PricingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PricingStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PricingStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PricingStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PricingStrategy(ctx, req.(*PricingStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetPricingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPricingStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetPricingStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetPricingStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetPricingStrategy(ctx, req.(*SetPricingStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_MintVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).MintVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/MintVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).MintVoucher(ctx, req.(*MintVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_Vouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VouchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).Vouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/Vouchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).Vouchers(ctx, req.(*VouchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RevokeVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RevokeVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RevokeVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RevokeVoucher(ctx, req.(*RevokeVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_VoucherRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoucherRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).VoucherRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/VoucherRedemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).VoucherRedemptions(ctx, req.(*VoucherRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RewardDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RewardDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RewardDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RewardDistributions(ctx, req.(*RewardDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_RemoveChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveChatMessageRequest)
 	if err := dec(in); err != nil {
@@ -799,6 +1407,10 @@ var _JungleTV_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EnqueueMedia",
 			Handler:    _JungleTV_EnqueueMedia_Handler,
 		},
+		{
+			MethodName: "GetEnqueuePricing",
+			Handler:    _JungleTV_GetEnqueuePricing_Handler,
+		},
 		{
 			MethodName: "RewardInfo",
 			Handler:    _JungleTV_RewardInfo_Handler,
@@ -819,6 +1431,26 @@ var _JungleTV_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserPermissionLevel",
 			Handler:    _JungleTV_UserPermissionLevel_Handler,
 		},
+		{
+			MethodName: "PlayedMediaHistory",
+			Handler:    _JungleTV_PlayedMediaHistory_Handler,
+		},
+		{
+			MethodName: "Channels",
+			Handler:    _JungleTV_Channels_Handler,
+		},
+		{
+			MethodName: "PrepaidBalanceStatement",
+			Handler:    _JungleTV_PrepaidBalanceStatement_Handler,
+		},
+		{
+			MethodName: "WithdrawPrepaidBalance",
+			Handler:    _JungleTV_WithdrawPrepaidBalance_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _JungleTV_RewardHistory_Handler,
+		},
 		{
 			MethodName: "ForciblyEnqueueTicket",
 			Handler:    _JungleTV_ForciblyEnqueueTicket_Handler,
@@ -827,6 +1459,58 @@ var _JungleTV_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveQueueEntry",
 			Handler:    _JungleTV_RemoveQueueEntry_Handler,
 		},
+		{
+			MethodName: "MoveQueueEntry",
+			Handler:    _JungleTV_MoveQueueEntry_Handler,
+		},
+		{
+			MethodName: "SetPlaybackPaused",
+			Handler:    _JungleTV_SetPlaybackPaused_Handler,
+		},
+		{
+			MethodName: "ScheduleMedia",
+			Handler:    _JungleTV_ScheduleMedia_Handler,
+		},
+		{
+			MethodName: "UnscheduleMedia",
+			Handler:    _JungleTV_UnscheduleMedia_Handler,
+		},
+		{
+			MethodName: "AutoEnqueueList",
+			Handler:    _JungleTV_AutoEnqueueList_Handler,
+		},
+		{
+			MethodName: "SetAutoEnqueueList",
+			Handler:    _JungleTV_SetAutoEnqueueList_Handler,
+		},
+		{
+			MethodName: "PricingStrategy",
+			Handler:    _JungleTV_PricingStrategy_Handler,
+		},
+		{
+			MethodName: "SetPricingStrategy",
+			Handler:    _JungleTV_SetPricingStrategy_Handler,
+		},
+		{
+			MethodName: "MintVoucher",
+			Handler:    _JungleTV_MintVoucher_Handler,
+		},
+		{
+			MethodName: "Vouchers",
+			Handler:    _JungleTV_Vouchers_Handler,
+		},
+		{
+			MethodName: "RevokeVoucher",
+			Handler:    _JungleTV_RevokeVoucher_Handler,
+		},
+		{
+			MethodName: "VoucherRedemptions",
+			Handler:    _JungleTV_VoucherRedemptions_Handler,
+		},
+		{
+			MethodName: "RewardDistributions",
+			Handler:    _JungleTV_RewardDistributions_Handler,
+		},
 		{
 			MethodName: "RemoveChatMessage",
 			Handler:    _JungleTV_RemoveChatMessage_Handler,
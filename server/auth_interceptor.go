@@ -32,11 +32,27 @@ func NewAuthInterceptor(jwtManager *JWTManager, authorizer UserAuthorizer) *Auth
 	return &AuthInterceptor{
 		jwtManager,
 		map[string]PermissionLevel{
-			"/jungletv.JungleTV/RewardInfo":      UserPermissionLevel,
-			"/jungletv.JungleTV/SendChatMessage": UserPermissionLevel,
+			"/jungletv.JungleTV/RewardInfo":              UserPermissionLevel,
+			"/jungletv.JungleTV/SendChatMessage":         UserPermissionLevel,
+			"/jungletv.JungleTV/PrepaidBalanceStatement": UserPermissionLevel,
+			"/jungletv.JungleTV/WithdrawPrepaidBalance":  UserPermissionLevel,
+			"/jungletv.JungleTV/RewardHistory":           UserPermissionLevel,
 
 			"/jungletv.JungleTV/ForciblyEnqueueTicket":    AdminPermissionLevel,
 			"/jungletv.JungleTV/RemoveQueueEntry":         AdminPermissionLevel,
+			"/jungletv.JungleTV/MoveQueueEntry":           AdminPermissionLevel,
+			"/jungletv.JungleTV/SetPlaybackPaused":        AdminPermissionLevel,
+			"/jungletv.JungleTV/ScheduleMedia":            AdminPermissionLevel,
+			"/jungletv.JungleTV/UnscheduleMedia":          AdminPermissionLevel,
+			"/jungletv.JungleTV/AutoEnqueueList":          AdminPermissionLevel,
+			"/jungletv.JungleTV/SetAutoEnqueueList":       AdminPermissionLevel,
+			"/jungletv.JungleTV/PricingStrategy":          AdminPermissionLevel,
+			"/jungletv.JungleTV/SetPricingStrategy":       AdminPermissionLevel,
+			"/jungletv.JungleTV/MintVoucher":              AdminPermissionLevel,
+			"/jungletv.JungleTV/Vouchers":                 AdminPermissionLevel,
+			"/jungletv.JungleTV/RevokeVoucher":            AdminPermissionLevel,
+			"/jungletv.JungleTV/VoucherRedemptions":       AdminPermissionLevel,
+			"/jungletv.JungleTV/RewardDistributions":      AdminPermissionLevel,
 			"/jungletv.JungleTV/RemoveChatMessage":        AdminPermissionLevel,
 			"/jungletv.JungleTV/SetChatSettings":          AdminPermissionLevel,
 			"/jungletv.JungleTV/SetVideoEnqueuingEnabled": AdminPermissionLevel,
@@ -0,0 +1,390 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// autoEnqueueRecentPicksToAvoid is how many of the most recently picked items are avoided when picking the next one,
+// as long as enough other items remain eligible
+const autoEnqueueRecentPicksToAvoid = 10
+
+// AutoEnqueueItem is a YouTube video that can be automatically enqueued when the queue is empty
+type AutoEnqueueItem struct {
+	ID string
+	// Weight is how likely the item is to be picked, relative to the other eligible items. Zero is the same as one
+	Weight int      `json:",omitempty"`
+	Tags   []string `json:",omitempty"`
+	// Title is informative only, the title shown to users is always the one obtained from YouTube
+	Title string `json:",omitempty"`
+}
+
+// AutoEnqueueRotation restricts which items can be picked during part of the day, in UTC.
+// Start and End are times of day, e.g. "20h30m". Rotations where End is before Start wrap around midnight
+type AutoEnqueueRotation struct {
+	Start Duration
+	End   Duration
+	// Tags are the tags of the items eligible during the rotation. Items with any of these tags are eligible
+	Tags []string
+}
+
+// AutoEnqueueList is the structured format of the auto-enqueue file
+type AutoEnqueueList struct {
+	Items     []AutoEnqueueItem
+	Rotations []AutoEnqueueRotation `json:",omitempty"`
+}
+
+// AutoEnqueueSource picks the media to automatically enqueue when the queue is empty.
+// The list is reloaded whenever the file it was read from changes. Files in the original format, consisting of one
+// video ID per line optionally followed by a description, are still accepted
+type AutoEnqueueSource struct {
+	log  *log.Logger
+	file string
+
+	mu          sync.Mutex
+	list        AutoEnqueueList
+	fileModTime time.Time
+	// unavailable maps the IDs of items that could not be enqueued to the reason why. Entries are removed when
+	// the list is changed, so that fixed items can be retried
+	unavailable map[string]string
+	recentPicks []string
+}
+
+// NewAutoEnqueueSource returns a new AutoEnqueueSource which reads the list from the given file, if not empty
+func NewAutoEnqueueSource(log *log.Logger, file string) (*AutoEnqueueSource, error) {
+	a := &AutoEnqueueSource{
+		log:         log,
+		file:        file,
+		unavailable: make(map[string]string),
+	}
+	if file != "" {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		err := a.reloadIfChangedNoMutex()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return a, nil
+}
+
+// Enabled returns whether there are items to automatically enqueue
+func (a *AutoEnqueueSource) Enabled() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reloadIfChangedLoggingErrorsNoMutex()
+	return len(a.list.Items) > 0
+}
+
+// List returns the current list along with the reasons why items were found to be unavailable, by item ID
+func (a *AutoEnqueueSource) List() (AutoEnqueueList, map[string]string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reloadIfChangedLoggingErrorsNoMutex()
+
+	unavailable := make(map[string]string, len(a.unavailable))
+	for id, reason := range a.unavailable {
+		unavailable[id] = reason
+	}
+	return a.list, unavailable
+}
+
+// SetList replaces the list, saving it to the file if there is one
+func (a *AutoEnqueueSource) SetList(list AutoEnqueueList) error {
+	err := list.validate()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file != "" {
+		marshalled, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		// the file is hot-reloaded, so it must never be seen partially written
+		tmpFile := a.file + ".tmp"
+		err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+		if err != nil {
+			return stacktrace.Propagate(err, "error writing auto enqueue list to file")
+		}
+		err = os.Rename(tmpFile, a.file)
+		if err != nil {
+			return stacktrace.Propagate(err, "error replacing auto enqueue list file")
+		}
+		info, err := os.Stat(a.file)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		a.fileModTime = info.ModTime()
+	}
+	a.setListNoMutex(list)
+	return nil
+}
+
+// MarkUnavailable excludes the item with the given ID from being picked until the list changes.
+// Returns false if the item was already marked as unavailable
+func (a *AutoEnqueueSource) MarkUnavailable(id, reason string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, present := a.unavailable[id]; present {
+		return false
+	}
+	a.unavailable[id] = reason
+	return true
+}
+
+// Pick randomly picks an item, taking into account the item weights and the rotation active at the given time.
+// Unavailable items, items in exclude and the most recently picked items are avoided.
+// Returns false if no item can be picked
+func (a *AutoEnqueueSource) Pick(now time.Time, exclude map[string]struct{}) (AutoEnqueueItem, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reloadIfChangedLoggingErrorsNoMutex()
+
+	candidates := []AutoEnqueueItem{}
+	for _, item := range a.list.Items {
+		_, excluded := exclude[item.ID]
+		_, unavailable := a.unavailable[item.ID]
+		if !excluded && !unavailable {
+			candidates = append(candidates, item)
+		}
+	}
+
+	for _, rotation := range a.list.Rotations {
+		if rotation.activeAt(now) {
+			inRotation := []AutoEnqueueItem{}
+			for _, item := range candidates {
+				if rotation.includes(item) {
+					inRotation = append(inRotation, item)
+				}
+			}
+			// a rotation without eligible items shouldn't stop auto-enqueuing altogether
+			if len(inRotation) > 0 {
+				candidates = inRotation
+			}
+			break
+		}
+	}
+
+	// avoid recently picked items, while always leaving at least half of the candidates to pick from
+	avoid := make(map[string]struct{})
+	for i := len(a.recentPicks) - 1; i >= 0 && len(avoid) < len(candidates)/2; i-- {
+		avoid[a.recentPicks[i]] = struct{}{}
+	}
+	notRecent := []AutoEnqueueItem{}
+	for _, item := range candidates {
+		if _, avoided := avoid[item.ID]; !avoided {
+			notRecent = append(notRecent, item)
+		}
+	}
+	if len(notRecent) > 0 {
+		candidates = notRecent
+	}
+
+	if len(candidates) == 0 {
+		return AutoEnqueueItem{}, false
+	}
+
+	totalWeight := 0
+	for _, item := range candidates {
+		totalWeight += item.weight()
+	}
+	r := rand.Intn(totalWeight)
+	picked := candidates[len(candidates)-1]
+	for _, item := range candidates {
+		r -= item.weight()
+		if r < 0 {
+			picked = item
+			break
+		}
+	}
+
+	a.recentPicks = append(a.recentPicks, picked.ID)
+	if len(a.recentPicks) > autoEnqueueRecentPicksToAvoid {
+		a.recentPicks = a.recentPicks[len(a.recentPicks)-autoEnqueueRecentPicksToAvoid:]
+	}
+	return picked, true
+}
+
+func (a *AutoEnqueueSource) setListNoMutex(list AutoEnqueueList) {
+	a.list = list
+	a.unavailable = make(map[string]string)
+}
+
+func (a *AutoEnqueueSource) reloadIfChangedLoggingErrorsNoMutex() {
+	if a.file == "" {
+		return
+	}
+	err := a.reloadIfChangedNoMutex()
+	if err != nil {
+		// keep using the previous list, otherwise a typo while editing the file would stop auto-enqueuing
+		a.log.Printf("error reloading auto enqueue list: %v", err)
+	}
+}
+
+func (a *AutoEnqueueSource) reloadIfChangedNoMutex() error {
+	info, err := os.Stat(a.file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "")
+	}
+	if info.ModTime().Equal(a.fileModTime) {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(a.file)
+	if err != nil {
+		return stacktrace.Propagate(err, "error reading auto enqueue list from file: %v", err)
+	}
+	list, err := decodeAutoEnqueueList(b)
+	if err != nil {
+		return stacktrace.Propagate(err, "error decoding auto enqueue list from file %s", a.file)
+	}
+	a.fileModTime = info.ModTime()
+	a.setListNoMutex(list)
+	a.log.Printf("Loaded auto enqueue list with %d items from %s", len(list.Items), a.file)
+	return nil
+}
+
+func decodeAutoEnqueueList(b []byte) (AutoEnqueueList, error) {
+	var list AutoEnqueueList
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		err := json.Unmarshal(trimmed, &list)
+		if err != nil {
+			return list, stacktrace.Propagate(err, "")
+		}
+		return list, stacktrace.Propagate(list.validate(), "")
+	}
+
+	// original format: one video ID per line, optionally followed by a space and a description
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, " ", 2)
+		item := AutoEnqueueItem{
+			ID: strings.TrimSpace(parts[0]),
+		}
+		if len(parts) > 1 {
+			item.Title = strings.TrimSpace(parts[1])
+		}
+		list.Items = append(list.Items, item)
+	}
+	return list, nil
+}
+
+func (l AutoEnqueueList) validate() error {
+	for i, item := range l.Items {
+		if item.ID == "" {
+			return stacktrace.NewError("item %d is missing an ID", i)
+		}
+		if item.Weight < 0 {
+			return stacktrace.NewError("item %s has a negative weight", item.ID)
+		}
+	}
+	for i, rotation := range l.Rotations {
+		start, end := time.Duration(rotation.Start), time.Duration(rotation.End)
+		if start < 0 || start >= 24*time.Hour || end < 0 || end > 24*time.Hour {
+			return stacktrace.NewError("rotation %d must start and end within a day", i)
+		}
+		if start == end {
+			return stacktrace.NewError("rotation %d starts and ends at the same time", i)
+		}
+		if len(rotation.Tags) == 0 {
+			return stacktrace.NewError("rotation %d has no tags", i)
+		}
+	}
+	return nil
+}
+
+func (i AutoEnqueueItem) weight() int {
+	if i.Weight <= 0 {
+		return 1
+	}
+	return i.Weight
+}
+
+func (r AutoEnqueueRotation) activeAt(t time.Time) bool {
+	t = t.UTC()
+	timeOfDay := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	start, end := time.Duration(r.Start), time.Duration(r.End)
+	if end < start {
+		return timeOfDay >= start || timeOfDay < end
+	}
+	return timeOfDay >= start && timeOfDay < end
+}
+
+func (r AutoEnqueueRotation) includes(item AutoEnqueueItem) bool {
+	for _, rotationTag := range r.Tags {
+		for _, itemTag := range item.Tags {
+			if rotationTag == itemTag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SerializeForAPI serializes the list for the API
+func (l AutoEnqueueList) SerializeForAPI(unavailable map[string]string) ([]*proto.AutoEnqueueItem, []*proto.AutoEnqueueRotation) {
+	items := make([]*proto.AutoEnqueueItem, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &proto.AutoEnqueueItem{
+			Id:                item.ID,
+			Weight:            uint32(item.Weight),
+			Tags:              item.Tags,
+			Title:             item.Title,
+			UnavailableReason: unavailable[item.ID],
+		}
+	}
+	rotations := make([]*proto.AutoEnqueueRotation, len(l.Rotations))
+	for i, rotation := range l.Rotations {
+		rotations[i] = &proto.AutoEnqueueRotation{
+			Start: durationpb.New(time.Duration(rotation.Start)),
+			End:   durationpb.New(time.Duration(rotation.End)),
+			Tags:  rotation.Tags,
+		}
+	}
+	return items, rotations
+}
+
+// autoEnqueueListFromAPI converts the list received from the API
+func autoEnqueueListFromAPI(items []*proto.AutoEnqueueItem, rotations []*proto.AutoEnqueueRotation) AutoEnqueueList {
+	list := AutoEnqueueList{
+		Items: make([]AutoEnqueueItem, len(items)),
+	}
+	for i, item := range items {
+		list.Items[i] = AutoEnqueueItem{
+			ID:     strings.TrimSpace(item.Id),
+			Weight: int(item.Weight),
+			Tags:   item.Tags,
+			Title:  item.Title,
+		}
+	}
+	for _, rotation := range rotations {
+		list.Rotations = append(list.Rotations, AutoEnqueueRotation{
+			Start: Duration(rotation.Start.AsDuration()),
+			End:   Duration(rotation.End.AsDuration()),
+			Tags:  rotation.Tags,
+		})
+	}
+	return list
+}
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/alexcesaro/statsd.v2"
+)
+
+// MainChannelID is the ID of the channel used by requests that don't specify a channel
+const MainChannelID = "main"
+
+// ChannelConfig contains the settings of a channel
+type ChannelConfig struct {
+	ID                       string
+	Name                     string
+	QueueFile                string
+	PlayedMediaHistoryFile   string
+	AutoEnqueueVideoListFile string
+	TicketsFile              string
+}
+
+// Channel is an independent stream with its own queue, chat, statistics and reward pool.
+// The wallet, the moderation store and the rate limiters are shared by all channels
+type Channel struct {
+	id   string
+	name string
+
+	mediaQueue         *MediaQueue
+	enqueueManager     *EnqueueManager
+	rewardsHandler     *RewardsHandler
+	statsHandler       *StatsHandler
+	chat               *ChatManager
+	mediaProviders     []MediaProvider
+	playedMediaHistory PlayedMediaHistoryStore
+
+	allowVideoEnqueuing proto.AllowedVideoEnqueuingType
+	autoEnqueue         *AutoEnqueueSource
+}
+
+func (s *grpcServer) newChannel(ctx context.Context, config ChannelConfig, paymentAccountPool *PaymentAccountPool,
+	hCaptchaSecret string, directMediaHosts []string, requesterQuota RequesterQuota,
+	fairQueueInterleaving bool) (*Channel, error) {
+	statsClient := s.statsClient
+	if config.ID != MainChannelID {
+		// keep the metrics of the main channel where they were before there were multiple channels
+		statsClient = statsClient.Clone(statsd.Prefix("channel." + config.ID))
+	}
+
+	c := &Channel{
+		id:                  config.ID,
+		name:                config.Name,
+		allowVideoEnqueuing: proto.AllowedVideoEnqueuingType_ENABLED,
+	}
+
+	var err error
+	c.autoEnqueue, err = NewAutoEnqueueSource(s.log, config.AutoEnqueueVideoListFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.mediaQueue, err = NewMediaQueue(ctx, s.log, statsClient, config.QueueFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.mediaQueue.SetRequesterQuota(requesterQuota)
+	c.mediaQueue.SetFairInterleaving(fairQueueInterleaving)
+
+	c.playedMediaHistory, err = NewPlayedMediaHistoryStoreMemory(config.PlayedMediaHistoryFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.statsHandler, err = NewStatsHandler(s.log, c.mediaQueue, statsClient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.enqueueManager, err = NewEnqueueManager(s.log, statsClient, c.mediaQueue, s.wallet, paymentAccountPool,
+		s.paymentAccountPendingWaitGroup, c.statsHandler, s.collectorAccount.Address(), s.moderationStore,
+		s.modLogWebhook, config.TicketsFile, s.refunder, s.pricer, s.confirmationSubscriber,
+		s.vouchers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.rewardsHandler, err = NewRewardsHandler(
+		s.log, statsClient, c.mediaQueue, s.ipReputationChecker, hCaptchaSecret, s.wallet, s.collectorAccountQueue,
+		s.workGenerator, s.paymentAccountPendingWaitGroup, paymentAccountPool, s.moderationStore, c.playedMediaHistory,
+		s.revenueSplitter, s.rewardLedger, config.ID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.chat, err = NewChatManager(s.log, statsClient, NewChatStoreMemory(10000), s.moderationStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.mediaProviders = []MediaProvider{
+		NewYouTubeMediaProvider(c.mediaQueue, s.youtube),
+		NewYouTubePlaylistMediaProvider(c.mediaQueue, s.youtube),
+	}
+	if len(directMediaHosts) > 0 {
+		c.mediaProviders = append(c.mediaProviders, NewDirectMediaProvider(c.mediaQueue, directMediaHosts))
+	}
+	return c, nil
+}
+
+// ID returns the ID of the channel
+func (c *Channel) ID() string {
+	return c.id
+}
+
+// Name returns the display name of the channel
+func (c *Channel) Name() string {
+	return c.name
+}
+
+// forModLog returns the suffix identifying the channel in moderation log messages.
+// It is empty for the main channel, so that messages about it read like they did before there were multiple channels
+func (c *Channel) forModLog() string {
+	if c.id == MainChannelID {
+		return ""
+	}
+	return fmt.Sprintf(" in channel %s", c.name)
+}
+
+// SerializeForAPI serializes the channel for the API
+func (c *Channel) SerializeForAPI(ctx context.Context) *proto.Channel {
+	return &proto.Channel{
+		Id:                c.id,
+		Name:              c.name,
+		CurrentlyWatching: uint32(c.statsHandler.CurrentlyWatching(ctx)),
+	}
+}
+
+// channel returns the channel with the given ID, or the main channel if the ID is empty.
+// The returned error is suitable for being returned to API clients
+func (s *grpcServer) channel(id string) (*Channel, error) {
+	if id == "" {
+		id = MainChannelID
+	}
+	c, ok := s.channels[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "channel %s not found", id)
+	}
+	return c, nil
+}
+
+// sortedChannels returns all channels, with the main channel first and the remaining ones sorted by ID
+func (s *grpcServer) sortedChannels() []*Channel {
+	channels := make([]*Channel, 0, len(s.channels))
+	for _, c := range s.channels {
+		channels = append(channels, c)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].id == MainChannelID || channels[j].id == MainChannelID {
+			return channels[i].id == MainChannelID
+		}
+		return channels[i].id < channels[j].id
+	})
+	return channels
+}
+
+// ticket returns the ticket with the given ID along with the channel it belongs to.
+// Returns a nil ticket if no channel has a ticket with that ID
+func (s *grpcServer) ticket(id string) (*Channel, EnqueueTicket) {
+	for _, c := range s.channels {
+		if ticket := c.enqueueManager.GetTicket(id); ticket != nil {
+			return c, ticket
+		}
+	}
+	return nil, nil
+}
+
+func (s *grpcServer) Channels(ctx context.Context, r *proto.ChannelsRequest) (*proto.ChannelsResponse, error) {
+	response := &proto.ChannelsResponse{}
+	for _, c := range s.sortedChannels() {
+		response.Channels = append(response.Channels, c.SerializeForAPI(ctx))
+	}
+	return response, nil
+}
+
+func (s *grpcServer) channelWorker(ctx context.Context, c *Channel, errChan chan<- error) {
+	go func(ctx context.Context) {
+		for {
+			s.log.Printf("Payments processor for channel %s starting/restarting", c.id)
+			err := c.enqueueManager.ProcessPaymentsWorker(ctx, s.ticketCheckPeriod)
+			if err == nil {
+				return
+			}
+			errChan <- stacktrace.Propagate(err, "")
+			select {
+			case <-ctx.Done():
+				s.log.Printf("Payments processor for channel %s done", c.id)
+				return
+			default:
+			}
+		}
+	}(ctx)
+
+	go func(ctx context.Context) {
+		for {
+			s.log.Printf("Rewards handler for channel %s starting/restarting", c.id)
+			err := c.rewardsHandler.Worker(ctx)
+			if err == nil {
+				return
+			}
+			errChan <- stacktrace.Propagate(err, "")
+			select {
+			case <-ctx.Done():
+				s.log.Printf("Rewards handler for channel %s done", c.id)
+				return
+			default:
+			}
+		}
+	}(ctx)
+
+	go c.mediaQueue.ProcessQueueWorker(ctx)
+	go s.chatSystemMessagesWorker(ctx, c, errChan)
+	go s.autoEnqueueWorker(ctx, c, errChan)
+}
+
+func (s *grpcServer) chatSystemMessagesWorker(ctx context.Context, c *Channel, errChan chan<- error) {
+	mediaChangedC := c.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
+	defer c.mediaQueue.mediaChanged.Unsubscribe(mediaChangedC)
+
+	entryAddedC := c.mediaQueue.entryAdded.Subscribe(event.AtLeastOnceGuarantee)
+	defer c.mediaQueue.entryAdded.Unsubscribe(entryAddedC)
+
+	rewardsDistributedC := c.rewardsHandler.rewardsDistributed.Subscribe(event.AtLeastOnceGuarantee)
+	defer c.rewardsHandler.rewardsDistributed.Unsubscribe(rewardsDistributedC)
+
+	for {
+		select {
+		case v := <-mediaChangedC:
+			var err error
+			if v[0] == nil {
+				_, err = c.chat.CreateSystemMessage(ctx, "_The queue is now empty._")
+			} else {
+				title := v[0].(MediaQueueEntry).MediaInfo().Title()
+				_, err = c.chat.CreateSystemMessage(ctx, fmt.Sprintf("_Now playing:_ %s", title))
+			}
+			if err != nil {
+				errChan <- stacktrace.Propagate(err, "")
+			}
+		case v := <-entryAddedC:
+			var err error
+			t := v[0].(string)
+			entry := v[1].(MediaQueueEntry)
+			title := entry.MediaInfo().Title()
+			if count := v[2].(int); count > 1 {
+				title = fmt.Sprintf("%s _and %d more_", title, count-1)
+			}
+			// announce entries under the name of who they are credited to, and anonymous entries without a name
+			creditedTo := entry.CreditedTo()
+			if !entry.RequestedBy().IsUnknown() || !creditedTo.IsUnknown() {
+				who := "Someone"
+				if !creditedTo.IsUnknown() {
+					who = creditedTo.Address()[:14]
+				}
+				switch t {
+				case "enqueue":
+					_, err = c.chat.CreateSystemMessage(ctx, fmt.Sprintf(
+						"_%s just enqueued_ %s", who, title))
+				case "play_after_next":
+					_, err = c.chat.CreateSystemMessage(ctx, fmt.Sprintf(
+						"_%s just set_ %s _to play after the current video_",
+						who, title))
+				case "play_now":
+					_, err = c.chat.CreateSystemMessage(ctx, fmt.Sprintf(
+						"_%s just skipped the previous video!_", who))
+				}
+				if err != nil {
+					errChan <- stacktrace.Propagate(err, "")
+				}
+			}
+		case v := <-rewardsDistributedC:
+			amount := v[0].(Amount)
+			eligibleCount := v[1].(int)
+			exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(29), nil)
+			banStr := new(big.Rat).SetFrac(amount.Int, exp).FloatString(2)
+
+			_, err := c.chat.CreateSystemMessage(ctx, fmt.Sprintf(
+				"_**%s BAN** distributed among %d spectators._", banStr, eligibleCount))
+			if err != nil {
+				errChan <- stacktrace.Propagate(err, "")
+			}
+		case <-ctx.Done():
+			s.log.Printf("Chat system message sender for channel %s done", c.id)
+			return
+		}
+	}
+}
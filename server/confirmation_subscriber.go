@@ -0,0 +1,322 @@
+package server
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+// confirmationFallbackCheckInterval is how often those relying on a ConfirmationSubscriber still poll the node while
+// the websocket is connected, in case a confirmation is missed
+const confirmationFallbackCheckInterval = 1 * time.Minute
+
+const confirmationSubscriberReconnectDelay = 5 * time.Second
+const confirmationSubscriberPingInterval = 30 * time.Second
+const confirmationSubscriberWriteTimeout = 10 * time.Second
+
+// ConfirmationSubscriber listens to the block confirmations of a Nano/Banano node websocket for the accounts being
+// watched, so that payments and sign-in verifications can be acted upon as soon as they are confirmed.
+// Confirmations may be missed while the websocket is disconnected, so this does not replace polling the node RPC,
+// it only allows for polling less often
+type ConfirmationSubscriber struct {
+	log *log.Logger
+	url string
+
+	mu        sync.Mutex
+	connected bool
+	// watchers maps accounts to the channels that are sent the confirmations involving the account
+	watchers map[string]map[chan<- ConfirmedBlock]struct{}
+	// pendingAdd and pendingDel are the changes to the watched accounts not yet sent to the node. They are sent by
+	// the connection, so that Watch and Unwatch never wait on the node
+	pendingAdd      map[string]struct{}
+	pendingDel      map[string]struct{}
+	updateRequested chan struct{}
+}
+
+// ConfirmedBlock is a confirmed block that involves a watched account, either because it belongs to the account or
+// because it sends money to the account
+type ConfirmedBlock struct {
+	Hash           string
+	Account        string
+	Subtype        string
+	LinkAsAccount  string
+	Representative string
+	Amount         *big.Int
+}
+
+// IsPaymentTo returns whether the block sends money to the specified account
+func (b ConfirmedBlock) IsPaymentTo(account string) bool {
+	return b.Subtype == "send" && b.LinkAsAccount == account
+}
+
+// NewConfirmationSubscriber returns a new ConfirmationSubscriber for the node websocket at the given URL
+func NewConfirmationSubscriber(log *log.Logger, url string) *ConfirmationSubscriber {
+	return &ConfirmationSubscriber{
+		log:             log,
+		url:             url,
+		watchers:        make(map[string]map[chan<- ConfirmedBlock]struct{}),
+		pendingAdd:      make(map[string]struct{}),
+		pendingDel:      make(map[string]struct{}),
+		updateRequested: make(chan struct{}, 1),
+	}
+}
+
+// Connected returns whether confirmations are currently being received
+func (c *ConfirmationSubscriber) Connected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+// Watch starts sending the confirmed blocks involving the account to ch. Confirmations are dropped if ch is full
+func (c *ConfirmationSubscriber) Watch(account string, ch chan<- ConfirmedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, present := c.watchers[account]; !present {
+		c.watchers[account] = make(map[chan<- ConfirmedBlock]struct{})
+		if _, present := c.pendingDel[account]; present {
+			delete(c.pendingDel, account)
+		} else {
+			c.pendingAdd[account] = struct{}{}
+		}
+		c.requestUpdate()
+	}
+	c.watchers[account][ch] = struct{}{}
+}
+
+// Unwatch stops sending the confirmed blocks involving the account to ch
+func (c *ConfirmationSubscriber) Unwatch(account string, ch chan<- ConfirmedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	chans, present := c.watchers[account]
+	if !present {
+		return
+	}
+	delete(chans, ch)
+	if len(chans) == 0 {
+		delete(c.watchers, account)
+		if _, present := c.pendingAdd[account]; present {
+			delete(c.pendingAdd, account)
+		} else {
+			c.pendingDel[account] = struct{}{}
+		}
+		c.requestUpdate()
+	}
+}
+
+// Worker keeps the websocket connected until the context is cancelled
+func (c *ConfirmationSubscriber) Worker(ctx context.Context) {
+	for {
+		err := c.connectAndListen(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		c.log.Printf("Node websocket disconnected, reconnecting in %s: %v", confirmationSubscriberReconnectDelay, err)
+		select {
+		case <-time.After(confirmationSubscriberReconnectDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type nodeWebsocketRequest struct {
+	Action  string      `json:"action"`
+	Topic   string      `json:"topic"`
+	Options interface{} `json:"options"`
+}
+
+type nodeConfirmationSubscribeOptions struct {
+	// an empty list means that no confirmations are sent, as opposed to omitting the list, which means all are sent
+	Accounts []string `json:"accounts"`
+}
+
+type nodeConfirmationUpdateOptions struct {
+	AccountsAdd []string `json:"accounts_add,omitempty"`
+	AccountsDel []string `json:"accounts_del,omitempty"`
+}
+
+type nodeWebsocketMessage struct {
+	Topic   string `json:"topic"`
+	Message struct {
+		Hash   string `json:"hash"`
+		Amount string `json:"amount"`
+		Block  struct {
+			Account        string `json:"account"`
+			Subtype        string `json:"subtype"`
+			LinkAsAccount  string `json:"link_as_account"`
+			Representative string `json:"representative"`
+		} `json:"block"`
+	} `json:"message"`
+}
+
+func (c *ConfirmationSubscriber) connectAndListen(ctx context.Context) error {
+	conn, _, err := websocket.Dial(ctx, c.url, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "error connecting to node websocket")
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	// the subscription includes every account watched so far, so the changes made before this point are not needed
+	accounts := func() []string {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		accounts := make([]string, 0, len(c.watchers))
+		for account := range c.watchers {
+			accounts = append(accounts, account)
+		}
+		c.pendingAdd = make(map[string]struct{})
+		c.pendingDel = make(map[string]struct{})
+		return accounts
+	}()
+	err = writeToNodeWebsocket(ctx, conn, nodeWebsocketRequest{
+		Action: "subscribe",
+		Topic:  "confirmation",
+		Options: nodeConfirmationSubscribeOptions{
+			Accounts: accounts,
+		},
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "error subscribing to confirmations")
+	}
+	c.setConnected(true)
+	c.log.Println("Connected to node websocket")
+	defer c.setConnected(false)
+
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go c.sendSubscriptionUpdates(listenCtx, conn)
+	go func() {
+		// the node doesn't necessarily send anything for a long time, so ping it to find out about dead connections
+		t := time.NewTicker(confirmationSubscriberPingInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				pingCtx, cancelPing := context.WithTimeout(listenCtx, confirmationSubscriberWriteTimeout)
+				err := conn.Ping(pingCtx)
+				cancelPing()
+				if err != nil {
+					conn.Close(websocket.StatusGoingAway, "ping failed")
+					return
+				}
+			case <-listenCtx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		var message nodeWebsocketMessage
+		err := wsjson.Read(listenCtx, conn, &message)
+		if err != nil {
+			return stacktrace.Propagate(err, "error reading from node websocket")
+		}
+		if message.Topic != "confirmation" {
+			continue
+		}
+		block := ConfirmedBlock{
+			Hash:           message.Message.Hash,
+			Account:        message.Message.Block.Account,
+			Subtype:        message.Message.Block.Subtype,
+			LinkAsAccount:  message.Message.Block.LinkAsAccount,
+			Representative: message.Message.Block.Representative,
+		}
+		if amount, ok := new(big.Int).SetString(message.Message.Amount, 10); ok {
+			block.Amount = amount
+		}
+		c.dispatch(block)
+	}
+}
+
+func (c *ConfirmationSubscriber) dispatch(block ConfirmedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sentTo := make(map[chan<- ConfirmedBlock]struct{})
+	for _, account := range []string{block.Account, block.LinkAsAccount} {
+		for ch := range c.watchers[account] {
+			if _, sent := sentTo[ch]; sent {
+				continue
+			}
+			sentTo[ch] = struct{}{}
+			select {
+			case ch <- block:
+			default:
+			}
+		}
+	}
+}
+
+func (c *ConfirmationSubscriber) setConnected(connected bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = connected
+}
+
+// requestUpdate wakes up the connection so that it sends the pending changes to the node, if connected
+func (c *ConfirmationSubscriber) requestUpdate() {
+	select {
+	case c.updateRequested <- struct{}{}:
+	default:
+		// an update is already pending and it will include these changes
+	}
+}
+
+func (c *ConfirmationSubscriber) takePendingUpdate() ([]string, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	add := make([]string, 0, len(c.pendingAdd))
+	for account := range c.pendingAdd {
+		add = append(add, account)
+	}
+	del := make([]string, 0, len(c.pendingDel))
+	for account := range c.pendingDel {
+		del = append(del, account)
+	}
+	c.pendingAdd = make(map[string]struct{})
+	c.pendingDel = make(map[string]struct{})
+	return add, del
+}
+
+// sendSubscriptionUpdates sends the changes to the watched accounts through conn until ctx is cancelled
+func (c *ConfirmationSubscriber) sendSubscriptionUpdates(ctx context.Context, conn *websocket.Conn) {
+	for {
+		select {
+		case <-c.updateRequested:
+		case <-ctx.Done():
+			return
+		}
+		add, del := c.takePendingUpdate()
+		if len(add) == 0 && len(del) == 0 {
+			continue
+		}
+		err := writeToNodeWebsocket(ctx, conn, nodeWebsocketRequest{
+			Action: "update",
+			Topic:  "confirmation",
+			Options: nodeConfirmationUpdateOptions{
+				AccountsAdd: add,
+				AccountsDel: del,
+			},
+		})
+		if err != nil {
+			// the connection is likely broken. Closing it causes the worker to reconnect with the full list of accounts
+			c.log.Printf("error updating node websocket subscription: %v", err)
+			conn.Close(websocket.StatusInternalError, "failed to update subscription")
+			return
+		}
+	}
+}
+
+func writeToNodeWebsocket(ctx context.Context, conn *websocket.Conn, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, confirmationSubscriberWriteTimeout)
+	defer cancel()
+	return stacktrace.Propagate(wsjson.Write(ctx, conn, v), "")
+}
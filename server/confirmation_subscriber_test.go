@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const testTimeout = 10*time.Second + confirmationSubscriberReconnectDelay
+
+// fakeNodeWebsocket accepts websocket connections the way a node would, exposing each connection and the requests
+// received through it
+type fakeNodeWebsocket struct {
+	server   *httptest.Server
+	conns    chan *websocket.Conn
+	requests chan receivedNodeRequest
+}
+
+type receivedNodeRequest struct {
+	Action  string          `json:"action"`
+	Topic   string          `json:"topic"`
+	Options json.RawMessage `json:"options"`
+}
+
+func newFakeNodeWebsocket(t *testing.T) *fakeNodeWebsocket {
+	f := &fakeNodeWebsocket{
+		conns:    make(chan *websocket.Conn, 10),
+		requests: make(chan receivedNodeRequest, 10),
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			t.Errorf("error accepting websocket connection: %v", err)
+			return
+		}
+		defer conn.Close(websocket.StatusNormalClosure, "")
+		f.conns <- conn
+		for {
+			var request receivedNodeRequest
+			err := wsjson.Read(context.Background(), conn, &request)
+			if err != nil {
+				return
+			}
+			f.requests <- request
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeNodeWebsocket) url() string {
+	return "ws" + strings.TrimPrefix(f.server.URL, "http")
+}
+
+func (f *fakeNodeWebsocket) nextConn(t *testing.T) *websocket.Conn {
+	t.Helper()
+	select {
+	case conn := <-f.conns:
+		return conn
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for connection")
+		return nil
+	}
+}
+
+func (f *fakeNodeWebsocket) nextRequest(t *testing.T) receivedNodeRequest {
+	t.Helper()
+	select {
+	case request := <-f.requests:
+		return request
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for request")
+		return receivedNodeRequest{}
+	}
+}
+
+func expectSubscription(t *testing.T, request receivedNodeRequest, accounts ...string) {
+	t.Helper()
+	if request.Action != "subscribe" || request.Topic != "confirmation" {
+		t.Fatalf("expected confirmation subscription, got %s of %s", request.Action, request.Topic)
+	}
+	var options nodeConfirmationSubscribeOptions
+	err := json.Unmarshal(request.Options, &options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.Accounts == nil {
+		// omitting the list would subscribe to every confirmation on the network
+		t.Fatal("subscription is missing the accounts list")
+	}
+	sort.Strings(options.Accounts)
+	sort.Strings(accounts)
+	if strings.Join(options.Accounts, ",") != strings.Join(accounts, ",") {
+		t.Fatalf("expected subscription to %v, got %v", accounts, options.Accounts)
+	}
+}
+
+func expectUpdate(t *testing.T, request receivedNodeRequest, add, del string) {
+	t.Helper()
+	if request.Action != "update" || request.Topic != "confirmation" {
+		t.Fatalf("expected confirmation subscription update, got %s of %s", request.Action, request.Topic)
+	}
+	var options nodeConfirmationUpdateOptions
+	err := json.Unmarshal(request.Options, &options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(options.AccountsAdd, ",") != add || strings.Join(options.AccountsDel, ",") != del {
+		t.Fatalf("expected update adding %q and removing %q, got %v and %v", add, del,
+			options.AccountsAdd, options.AccountsDel)
+	}
+}
+
+func waitForConnected(t *testing.T, c *ConfirmationSubscriber, connected bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for c.Connected() != connected {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for Connected() to be %v", connected)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func sendConfirmation(t *testing.T, conn *websocket.Conn, hash, account, linkAsAccount, amount string) {
+	t.Helper()
+	message := map[string]interface{}{
+		"topic": "confirmation",
+		"message": map[string]interface{}{
+			"hash":   hash,
+			"amount": amount,
+			"block": map[string]interface{}{
+				"account":         account,
+				"subtype":         "send",
+				"link_as_account": linkAsAccount,
+			},
+		},
+	}
+	err := wsjson.Write(context.Background(), conn, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConfirmationSubscriber(t *testing.T) {
+	node := newFakeNodeWebsocket(t)
+	c := NewConfirmationSubscriber(log.New(ioutil.Discard, "", 0), node.url())
+
+	ch := make(chan ConfirmedBlock, 10)
+	c.Watch("ban_watched", ch)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Worker(ctx)
+
+	conn := node.nextConn(t)
+	expectSubscription(t, node.nextRequest(t), "ban_watched")
+	waitForConnected(t, c, true)
+
+	c.Watch("ban_other", ch)
+	expectUpdate(t, node.nextRequest(t), "ban_other", "")
+	c.Unwatch("ban_other", ch)
+	expectUpdate(t, node.nextRequest(t), "", "ban_other")
+
+	sendConfirmation(t, conn, "HASH1", "ban_sender", "ban_watched", "1000")
+	select {
+	case block := <-ch:
+		if block.Hash != "HASH1" || !block.IsPaymentTo("ban_watched") {
+			t.Fatalf("unexpected block %+v", block)
+		}
+		if block.Amount == nil || block.Amount.String() != "1000" {
+			t.Fatalf("unexpected amount %v", block.Amount)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for confirmation")
+	}
+
+	// the node disconnecting must result in a new subscription with the accounts currently watched
+	c.Watch("ban_later", ch)
+	expectUpdate(t, node.nextRequest(t), "ban_later", "")
+	conn.Close(websocket.StatusGoingAway, "")
+	waitForConnected(t, c, false)
+	node.nextConn(t)
+	expectSubscription(t, node.nextRequest(t), "ban_watched", "ban_later")
+	waitForConnected(t, c, true)
+}
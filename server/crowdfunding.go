@@ -0,0 +1,109 @@
+package server
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/hectorchu/gonano/wallet"
+	"github.com/palantir/stacktrace"
+)
+
+// CrowdfundedTicketExpiration is how long crowdfunded tickets stay open, as it takes longer to gather payments from
+// multiple senders
+const CrowdfundedTicketExpiration = 15 * time.Minute
+
+// crowdfundingHistoryLength is how many blocks of the history of a payment account are looked at to find the
+// contributors of a crowdfunded ticket
+const crowdfundingHistoryLength = 100
+
+// Contribution is the amount sent by one of the contributors of a crowdfunded ticket
+type Contribution struct {
+	Address string
+	Amount  *big.Int
+}
+
+// findContributions returns how much each sender paid to the account since the account last sent money, in the
+// order in which they first contributed. Pendings must be received beforehand, otherwise they aren't included
+func findContributions(w *wallet.Wallet, account *wallet.Account) ([]Contribution, error) {
+	history, _, err := w.RPC.AccountHistory(account.Address(), crowdfundingHistoryLength, nil)
+	if err != nil {
+		if _, ok := err.(*json.UnmarshalTypeError); !ok {
+			return nil, stacktrace.Propagate(err, "failed to retrieve history for account %v", account.Address())
+		}
+		// account has no history. When this happens the node returns history: "" (which is not an empty array)
+		history = nil
+	}
+
+	// history is ordered from newest to oldest
+	receives := 0
+	for ; receives < len(history); receives++ {
+		if history[receives].Type == "send" {
+			// payments received before this were for a previous ticket
+			break
+		}
+	}
+
+	contributions := []Contribution{}
+	indexByAddress := make(map[string]int)
+	for i := receives - 1; i >= 0; i-- {
+		historyEntry := history[i]
+		if historyEntry.Type != "receive" || historyEntry.Amount == nil {
+			continue
+		}
+		if j, ok := indexByAddress[historyEntry.Account]; ok {
+			contributions[j].Amount.Add(contributions[j].Amount, &historyEntry.Amount.Int)
+			continue
+		}
+		indexByAddress[historyEntry.Account] = len(contributions)
+		contributions = append(contributions, Contribution{
+			Address: historyEntry.Account,
+			Amount:  new(big.Int).Set(&historyEntry.Amount.Int),
+		})
+	}
+	return contributions, nil
+}
+
+// splitAmongContributors splits the amount in proportion to the contributions. The remainder of the division goes to
+// the largest contributor, so that the shares always add up to the amount
+func splitAmongContributors(amount *big.Int, contributions []Contribution) []Contribution {
+	total := big.NewInt(0)
+	largest := 0
+	for i, contribution := range contributions {
+		total.Add(total, contribution.Amount)
+		if contribution.Amount.Cmp(contributions[largest].Amount) > 0 {
+			largest = i
+		}
+	}
+	if total.Sign() <= 0 {
+		return []Contribution{}
+	}
+
+	shares := make([]Contribution, len(contributions))
+	remaining := new(big.Int).Set(amount)
+	for i, contribution := range contributions {
+		share := new(big.Int).Mul(amount, contribution.Amount)
+		share.Div(share, total)
+		remaining.Sub(remaining, share)
+		shares[i] = Contribution{
+			Address: contribution.Address,
+			Amount:  share,
+		}
+	}
+	shares[largest].Amount.Add(shares[largest].Amount, remaining)
+	return shares
+}
+
+// largestContributor returns the address of who contributed the most, or an empty string if there are no
+// contributions
+func largestContributor(contributions []Contribution) string {
+	address := ""
+	largest := big.NewInt(0)
+	for _, contribution := range contributions {
+		if contribution.Amount.Cmp(largest) > 0 {
+			address = contribution.Address
+			largest = contribution.Amount
+		}
+	}
+	return address
+}
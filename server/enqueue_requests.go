@@ -33,6 +33,12 @@ type EnqueueManager struct {
 	log                            *log.Logger
 	moderationStore                ModerationStore
 	modLogWebhook                  api.WebhookClient
+	persistenceFile                string
+	refunder                       *Refunder
+	pricer                         *Pricer
+	confirmations                  *ConfirmationSubscriber
+	vouchers                       *VoucherManager
+	paymentsToCheck                chan ConfirmedBlock
 
 	requests     map[string]EnqueueTicket
 	requestsLock sync.RWMutex
@@ -50,6 +56,7 @@ type EnqueueTicket interface {
 	EnqueueRequest
 	ID() string
 	CreatedAt() time.Time
+	Expiration() time.Time
 	RequestedBy() User
 	PaymentAccount() *wallet.Account
 	SerializeForAPI() *proto.EnqueueMediaTicket
@@ -57,8 +64,68 @@ type EnqueueTicket interface {
 	SetPaid() error
 	Status() proto.EnqueueMediaTicketStatus
 	StatusChanged() *event.Event
+	// ForceEnqueuing should only be called by EnqueueManager.ForceEnqueuing, which persists the change
 	ForceEnqueuing(proto.ForcedTicketEnqueueType)
 	EnqueuingForced() (bool, proto.ForcedTicketEnqueueType)
+	// Crowdfunding returns whether the ticket is crowdfunded and, if so, the tier it must reach to be enqueued
+	Crowdfunding() (bool, proto.ForcedTicketEnqueueType)
+	Credit() EnqueueCredit
+	// VoucherRedemptionID returns the redemption of the voucher used with the ticket, if any
+	VoucherRedemptionID() string
+}
+
+// TicketOptions are the optional settings of a ticket
+type TicketOptions struct {
+	// CrowdfundingTargetTier makes the ticket crowdfunded: it can be paid by multiple senders, and instead of being
+	// enqueued at whatever tier the payment reaches, it stays open until the price of the target tier is reached
+	CrowdfundingTargetTier *proto.ForcedTicketEnqueueType
+	Credit                 EnqueueCredit
+	// Voucher discounts the prices of the ticket. Free enqueue vouchers don't affect the prices, as the enqueuing of
+	// the ticket is forced instead
+	Voucher *Voucher
+	// VoucherRedemptionID is the redemption of Voucher, which is cancelled if the ticket expires unpaid
+	VoucherRedemptionID string
+}
+
+// EnqueueCredit is who enqueued media is publicly attributed to. Rewards and reimbursements always concern whoever
+// paid for the media, regardless of who it is credited to
+type EnqueueCredit struct {
+	// CreditedTo is shown as having enqueued the media instead of the requester, if set
+	CreditedTo User
+	// Anonymous hides who enqueued the media, unless it is credited to someone else
+	Anonymous bool
+}
+
+// resolve returns the user shown as having enqueued media requested by requestedBy, which is an unknown user when
+// nobody should be shown
+func (c EnqueueCredit) resolve(requestedBy User) User {
+	switch {
+	case c.CreditedTo != nil && !c.CreditedTo.IsUnknown():
+		return c.CreditedTo
+	case c.Anonymous:
+		return &unknownUser{}
+	default:
+		return requestedBy
+	}
+}
+
+// creditedToAddress returns the address of the user the credit is for, or an empty string if there is none, for
+// persistence
+func (c EnqueueCredit) creditedToAddress() string {
+	if c.CreditedTo == nil || c.CreditedTo.IsUnknown() {
+		return ""
+	}
+	return c.CreditedTo.Address()
+}
+
+func enqueueCreditFromPersisted(creditedTo string, anonymous bool) EnqueueCredit {
+	credit := EnqueueCredit{
+		Anonymous: anonymous,
+	}
+	if creditedTo != "" {
+		credit.CreditedTo = NewAddressOnlyUser(creditedTo)
+	}
+	return credit
 }
 
 // NewEnqueueManager returns a new EnqueueManager
@@ -71,8 +138,13 @@ func NewEnqueueManager(log *log.Logger,
 	statsHandler *StatsHandler,
 	collectorAccountAddress string,
 	moderationStore ModerationStore,
-	modLogWebhook api.WebhookClient) (*EnqueueManager, error) {
-	return &EnqueueManager{
+	modLogWebhook api.WebhookClient,
+	persistenceFile string,
+	refunder *Refunder,
+	pricer *Pricer,
+	confirmations *ConfirmationSubscriber,
+	vouchers *VoucherManager) (*EnqueueManager, error) {
+	e := &EnqueueManager{
 		log:                            log,
 		statsClient:                    statsClient,
 		mediaQueue:                     mediaQueue,
@@ -84,14 +156,28 @@ func NewEnqueueManager(log *log.Logger,
 		requests:                       make(map[string]EnqueueTicket),
 		moderationStore:                moderationStore,
 		modLogWebhook:                  modLogWebhook,
-	}, nil
+		persistenceFile:                persistenceFile,
+		refunder:                       refunder,
+		pricer:                         pricer,
+		confirmations:                  confirmations,
+		vouchers:                       vouchers,
+		paymentsToCheck:                make(chan ConfirmedBlock, 100),
+	}
+	if persistenceFile != "" {
+		err := e.restoreTicketsFromFile()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return e, nil
 }
 
-func (e *EnqueueManager) RegisterRequest(ctx context.Context, request EnqueueRequest) (EnqueueTicket, error) {
+func (e *EnqueueManager) RegisterRequest(ctx context.Context, request EnqueueRequest, options TicketOptions) (EnqueueTicket, error) {
 	var err error
 	var paymentAccount *wallet.Account
 	for {
-		paymentAccount, err = e.paymentAccountPool.RequestAccount()
+		var reused bool
+		paymentAccount, reused, err = e.paymentAccountPool.RequestAccount()
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "")
 		}
@@ -108,25 +194,41 @@ func (e *EnqueueManager) RegisterRequest(ctx context.Context, request EnqueueReq
 		if balance.Cmp(big.NewInt(0)) == 0 {
 			break
 		}
-		e.modLogWebhook.SendContent(fmt.Sprintf(
-			"Address %v has unhandled balance! (gbl08ma will issue a refund)\n"+
-				"Most likely, someone sent money to this address after their payment ticket had already expired.\n"+
-				"This address has been removed from the payment account pool for the time being.",
-			paymentAccount.Address()))
+		// reused accounts only go back to the pool once they are empty, so their balance must have been sent after
+		// the ticket was gone. For accounts that were never handed out, it is not known whether this is from a late
+		// payment or from a payment that could not be collected
+		reason := RefundReasonLatePayment
+		if !reused {
+			reason = RefundReasonUnhandledBalance
+		}
+		// if the sender can't be identified, moderators are alerted and the account stays out of the pool
+		go e.refundAndReturnAccount(paymentAccount, balance, reason, "")
+	}
+
+	pricing := e.ComputePricing(ctx, request)
+	voucherCode, voucherRedemptionID := "", ""
+	if options.Voucher != nil {
+		pricing = options.Voucher.ApplyToPricing(pricing)
+		voucherCode = options.Voucher.Code
+		voucherRedemptionID = options.VoucherRedemptionID
 	}
 
 	t := &ticket{
-		id:            uuid.NewV4().String(),
-		createdAt:     time.Now(),
-		requestedBy:   request.RequestedBy(),
-		mediaInfo:     request.MediaInfo(),
-		unskippable:   request.Unskippable(),
-		pricing:       ComputeEnqueuePricing(e.mediaQueue, e.statsHandler.CurrentlyWatching(ctx), request.MediaInfo().Length(), request.Unskippable()),
-		account:       paymentAccount,
-		statusChanged: event.New(),
+		id:                     uuid.NewV4().String(),
+		createdAt:              time.Now(),
+		requestedBy:            request.RequestedBy(),
+		mediaInfo:              request.MediaInfo(),
+		unskippable:            request.Unskippable(),
+		pricing:                pricing,
+		account:                paymentAccount,
+		statusChanged:          event.New(),
+		crowdfundingTargetTier: options.CrowdfundingTargetTier,
+		credit:                 options.Credit,
+		voucherCode:            voucherCode,
+		voucherRedemptionID:    voucherRedemptionID,
 	}
 	go func() {
-		<-time.NewTimer(TicketExpiration).C
+		<-time.NewTimer(time.Until(t.Expiration())).C
 		t.statusChanged.Notify()
 	}()
 
@@ -135,9 +237,16 @@ func (e *EnqueueManager) RegisterRequest(ctx context.Context, request EnqueueReq
 	e.requestsLock.Lock()
 	defer e.requestsLock.Unlock()
 	e.requests[t.ID()] = t
+	e.persistTicketsNoMutex()
+	e.watchPaymentAccount(t.account)
 	return t, nil
 }
 
+// ComputePricing returns the prices that a ticket for the request would have if it were registered now
+func (e *EnqueueManager) ComputePricing(ctx context.Context, request EnqueueRequest) EnqueuePricing {
+	return e.pricer.ComputeMediaEnqueuePricing(e.mediaQueue, e.statsHandler.CurrentlyWatching(ctx), request.MediaInfo(), request.Unskippable())
+}
+
 func (e *EnqueueManager) ProcessPayments(ctx context.Context) error {
 	// create a copy of the map so we don't hold the lock for so long
 	requestCopy := make(map[string]EnqueueTicket)
@@ -169,16 +278,9 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 	if request.Status() == proto.EnqueueMediaTicketStatus_PAID {
 		return nil
 	}
-	if time.Now().After(request.CreatedAt().Add(TicketExpiration).Add(1 * time.Minute)) {
-		func() {
-			e.requestsLock.Lock()
-			defer e.requestsLock.Unlock()
-			delete(e.requests, reqID)
-		}()
-		e.paymentAccountPool.ReturnAccount(request.PaymentAccount())
-		e.log.Printf("Purged ticket %s with payment address %s", reqID, request.PaymentAccount().Address())
-		return nil
-	}
+	// the balance is checked once more before purging expired tickets, so that payments sent close to the
+	// expiration (or while the server was down, for tickets restored after a restart) are still honored
+	expired := time.Now().After(request.Expiration().Add(1 * time.Minute))
 	t := e.statsClient.NewTiming()
 	defer t.Send("check_enqueue_ticket")
 
@@ -190,16 +292,31 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 
 	pricing := request.RequestPricing()
 	forceEnqueuing, forcedEnqueuingType := request.EnqueuingForced()
+	crowdfunded, targetTier := request.Crowdfunding()
+	tierMet := func(tier proto.ForcedTicketEnqueueType, price Amount) bool {
+		if forceEnqueuing && forcedEnqueuingType == tier {
+			return true
+		}
+		// crowdfunded tickets don't settle for tiers below the target
+		return balance.Cmp(price.Int) >= 0 && (!crowdfunded || tier >= targetTier)
+	}
 
-	var playFn func(MediaQueueEntry)
-	if balance.Cmp(pricing.PlayNowPrice.Int) >= 0 || (forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_PLAY_NOW) {
+	var playFn func(...MediaQueueEntry)
+	var tierPrice Amount
+	if tierMet(proto.ForcedTicketEnqueueType_PLAY_NOW, pricing.PlayNowPrice) {
 		playFn = e.mediaQueue.PlayNow
-	} else if balance.Cmp(pricing.PlayNextPrice.Int) >= 0 || (forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_PLAY_NEXT) {
+		tierPrice = pricing.PlayNowPrice
+	} else if tierMet(proto.ForcedTicketEnqueueType_PLAY_NEXT, pricing.PlayNextPrice) {
 		playFn = e.mediaQueue.PlayAfterNext
-	} else if balance.Cmp(pricing.EnqueuePrice.Int) >= 0 || (forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_ENQUEUE) {
+		tierPrice = pricing.PlayNextPrice
+	} else if tierMet(proto.ForcedTicketEnqueueType_ENQUEUE, pricing.EnqueuePrice) {
 		playFn = e.mediaQueue.Enqueue
+		tierPrice = pricing.EnqueuePrice
 	} else {
 		// yet to receive enough money
+		if expired {
+			e.purgeTicket(request, balance)
+		}
 		return nil
 	}
 	e.log.Printf("Ticket %s meets requirements for enqueuing", reqID)
@@ -208,7 +325,23 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 	defer t2.Send("enqueue_ticket")
 
 	requestedBy := request.RequestedBy()
-	if requestedBy.IsUnknown() && balance.Cmp(big.NewInt(0)) > 0 {
+	var contributions []Contribution
+	if crowdfunded && balance.Cmp(big.NewInt(0)) > 0 {
+		// we must receive pendings otherwise the history might not contain the latest tx
+		err := request.PaymentAccount().ReceivePendings()
+		if err != nil {
+			e.log.Printf("failed to receive pendings in account %v: %v", request.PaymentAccount().Address(), err)
+			return nil
+		}
+
+		contributions, err = findContributions(e.wallet, request.PaymentAccount())
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if address := largestContributor(contributions); requestedBy.IsUnknown() && address != "" {
+			requestedBy = NewAddressOnlyUser(address)
+		}
+	} else if requestedBy.IsUnknown() && balance.Cmp(big.NewInt(0)) > 0 {
 		// requested by unauthenticated user, set the user to be who paid
 
 		// we must receive pendings otherwise the history might not contain the latest tx
@@ -224,10 +357,26 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 		}
 	}
 
+	// when enqueuing is forced, the price of the tier may not have been met, in which case there's no excess
+	excess := big.NewInt(0)
+	if balance.Cmp(tierPrice.Int) > 0 {
+		excess = e.refunder.ExcessToRefund(balance, tierPrice.Int)
+	}
+	cost := new(big.Int).Sub(balance, excess)
+	planExcessRefund := e.refunder.planRefund
+	if crowdfunded {
+		// the excess is refunded in proportion to the contributions, so what remains of each contribution is what
+		// each contributor paid towards the cost
+		planExcessRefund = e.refunder.planContributorsRefund
+		for i, share := range splitAmongContributors(excess, contributions) {
+			contributions[i].Amount.Sub(contributions[i].Amount, share.Amount)
+		}
+	}
+
 	// user can still be nil here, in case we couldn't find it in the last 10 account blocks
 	mi := request.MediaInfo()
 	e.paymentAccountPendingWaitGroup.Add(1)
-	playFn(mi.ProduceMediaQueueEntry(requestedBy, Amount{balance}, request.Unskippable(), request.ID()))
+	playFn(mi.ProduceMediaQueueEntries(requestedBy, Amount{cost}, request.Unskippable(), request.ID(), contributions, request.Credit())...)
 
 	err = request.SetPaid()
 	if err != nil {
@@ -243,21 +392,30 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 		}
 	}
 
-	e.log.Printf("Enqueued ticket %s - video \"%s\" with length %s - requested by %s with cost %s",
+	e.log.Printf("Enqueued ticket %s - video \"%s\" with length %s - requested by %s with cost %s from %d contributors",
 		reqID,
 		mi.Title(),
 		mi.Length().String(),
 		requestedByStr,
-		balance.String())
+		cost.String(),
+		len(contributions))
 
 	e.requestsLock.Lock()
 	defer e.requestsLock.Unlock()
 	delete(e.requests, reqID)
+	e.persistTicketsNoMutex()
+	e.unwatchPaymentAccount(request.PaymentAccount())
 
 	go func(reqID string, request EnqueueTicket) {
 		t := e.statsClient.NewTiming()
 		defer t.Send("enqueue_ticket_final_operations")
 
+		// the cost is sent first, as spectators are rewarded from the collector account once the entry plays.
+		// The refunds of the excess must be planned before that, as the senders are found by looking at what the
+		// account received since it last sent money.
+		// Each step is only done once, even if a later one fails and is retried
+		unsentCost := new(big.Int).Set(cost)
+		var refunds []refundAllocation
 		retry := 0
 		for ; retry < 3; retry++ {
 			err := request.PaymentAccount().ReceivePendings()
@@ -266,13 +424,32 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 				time.Sleep(1 * time.Second)
 				continue
 			}
-			if balance.Cmp(big.NewInt(0)) > 0 {
-				_, err = request.PaymentAccount().Send(e.collectorAccountAddress, balance)
+			if refunds == nil {
+				refunds, err = planExcessRefund(request.PaymentAccount(), excess)
+				if err != nil {
+					e.log.Printf("failed to determine who to refund in account %v: %v", request.PaymentAccount().Address(), err)
+					time.Sleep(1 * time.Second)
+					continue
+				}
+			}
+			if unsentCost.Sign() > 0 {
+				_, err = request.PaymentAccount().Send(e.collectorAccountAddress, unsentCost)
 				if err != nil {
 					e.log.Printf("failed to send balance in account %v to the collector account: %v", request.PaymentAccount().Address(), err)
 					time.Sleep(1 * time.Second)
 					continue
 				}
+				unsentCost.SetInt64(0)
+			}
+			if len(refunds) > 0 {
+				var refunded *big.Int
+				refunded, refunds, err = e.refunder.sendRefunds(request.PaymentAccount(), refunds, RefundReasonExcessPayment, reqID)
+				excess.Sub(excess, refunded)
+				if err != nil {
+					e.log.Printf("failed to refund excess payment in account %v: %v", request.PaymentAccount().Address(), err)
+					time.Sleep(1 * time.Second)
+					continue
+				}
 			}
 			break
 		}
@@ -281,11 +458,72 @@ func (e *EnqueueManager) processPaymentForTicket(ctx context.Context, reqID stri
 		if retry < 3 {
 			// only reuse the account if no funds got stuck there
 			e.paymentAccountPool.ReturnAccount(request.PaymentAccount())
+			return
+		}
+		e.paymentAccountPool.ReleaseAccount(request.PaymentAccount())
+		if e.modLogWebhook != nil {
+			e.modLogWebhook.SendContent(fmt.Sprintf(
+				"Funds got stuck in address %v after enqueuing ticket %s (cost not sent to the collector %v, excess to refund %v).\n"+
+					"This address has been removed from the payment account pool for the time being.",
+				request.PaymentAccount().Address(), reqID, unsentCost, excess))
 		}
 	}(reqID, request)
 	return nil
 }
 
+// purgeTicket removes an expired ticket, refunding whatever was paid towards it
+func (e *EnqueueManager) purgeTicket(request EnqueueTicket, balance *big.Int) {
+	func() {
+		e.requestsLock.Lock()
+		defer e.requestsLock.Unlock()
+		delete(e.requests, request.ID())
+		e.persistTicketsNoMutex()
+	}()
+	e.unwatchPaymentAccount(request.PaymentAccount())
+	e.log.Printf("Purged ticket %s with payment address %s", request.ID(), request.PaymentAccount().Address())
+	if redemptionID := request.VoucherRedemptionID(); redemptionID != "" && e.vouchers != nil {
+		// the voucher was not used to enqueue anything, so it can be redeemed again
+		e.vouchers.CancelRedemption(redemptionID)
+	}
+	if crowdfunded, _ := request.Crowdfunding(); crowdfunded && balance.Sign() > 0 {
+		go e.refundContributorsAndReturnAccount(request.PaymentAccount(), balance, request.ID())
+		return
+	}
+	if balance.Sign() > 0 {
+		go e.refundAndReturnAccount(request.PaymentAccount(), balance, RefundReasonInsufficientPayment, request.ID())
+		return
+	}
+	e.paymentAccountPool.ReturnAccount(request.PaymentAccount())
+}
+
+// refundAndReturnAccount refunds the balance of a payment account and returns it to the pool if that succeeds
+func (e *EnqueueManager) refundAndReturnAccount(account *wallet.Account, balance *big.Int, reason RefundReason, ticketID string) {
+	refunded, err := e.refunder.Refund(account, balance, reason, ticketID)
+	e.returnAccountAfterRefund(account, balance, refunded, err)
+}
+
+// refundContributorsAndReturnAccount refunds the balance of the payment account of a crowdfunded ticket to its
+// contributors and returns the account to the pool if that succeeds
+func (e *EnqueueManager) refundContributorsAndReturnAccount(account *wallet.Account, balance *big.Int, ticketID string) {
+	refunded, err := e.refunder.RefundContributors(account, balance, RefundReasonUnfundedCrowdfunding, ticketID)
+	e.returnAccountAfterRefund(account, balance, refunded, err)
+}
+
+func (e *EnqueueManager) returnAccountAfterRefund(account *wallet.Account, balance, refunded *big.Int, err error) {
+	if err == nil {
+		e.paymentAccountPool.ReturnAccount(account)
+		return
+	}
+	e.log.Printf("failed to refund balance in account %v: %v", account.Address(), err)
+	e.paymentAccountPool.ReleaseAccount(account)
+	if e.modLogWebhook != nil {
+		e.modLogWebhook.SendContent(fmt.Sprintf(
+			"Address %v has unhandled balance! (automatic refund failed after refunding %v of %v; gbl08ma will issue a refund)\n"+
+				"This address has been removed from the payment account pool for the time being.",
+			account.Address(), refunded, balance))
+	}
+}
+
 func (e *EnqueueManager) findUserWhoPaid(account *wallet.Account) (User, error) {
 	var user User
 	history, _, err := e.wallet.RPC.AccountHistory(account.Address(), 10, nil)
@@ -305,27 +543,118 @@ func (e *EnqueueManager) findUserWhoPaid(account *wallet.Account) (User, error)
 	return user, nil
 }
 
+// ProcessPaymentsWorker checks the payment accounts of all tickets every interval. When confirmations are being
+// received from the node websocket, tickets are checked as soon as a payment to them is confirmed, and all tickets
+// are only checked every confirmationFallbackCheckInterval
 func (e *EnqueueManager) ProcessPaymentsWorker(ctx context.Context, interval time.Duration) error {
 	t := time.NewTicker(interval)
+	defer t.Stop()
+	lastProcessed := time.Time{}
 	for {
 		select {
 		case <-t.C:
+			if e.confirmations != nil && e.confirmations.Connected() &&
+				time.Since(lastProcessed) < confirmationFallbackCheckInterval {
+				continue
+			}
 			err := e.ProcessPayments(ctx)
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
+			lastProcessed = time.Now()
+		case block := <-e.paymentsToCheck:
+			reqID, request := e.ticketWithPaymentAccount(block.LinkAsAccount)
+			if request == nil || !block.IsPaymentTo(request.PaymentAccount().Address()) {
+				continue
+			}
+			if block.Hash != "" {
+				e.log.Printf("Checking ticket %s after payment %s", reqID, block.Hash)
+			}
+			err := e.processPaymentForTicket(ctx, reqID, request)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
 		case <-ctx.Done():
 			return nil
 		}
 	}
 }
 
+// PaymentSent makes the ticket be checked right away, for payments sent by JungleTV itself, which aren't necessarily
+// from accounts that are being watched for confirmations
+func (e *EnqueueManager) PaymentSent(ticket EnqueueTicket, hash string) {
+	select {
+	case e.paymentsToCheck <- ConfirmedBlock{
+		Hash:          hash,
+		Subtype:       "send",
+		LinkAsAccount: ticket.PaymentAccount().Address(),
+	}:
+	default:
+	}
+}
+
+// CheckTicket makes the ticket be checked right away, e.g. after its enqueuing is forced
+func (e *EnqueueManager) CheckTicket(ticket EnqueueTicket) {
+	e.PaymentSent(ticket, "")
+}
+
+// ForceEnqueuing makes the ticket be enqueued without payment the next time it is checked, persisting the tickets so
+// that this survives restarts
+func (e *EnqueueManager) ForceEnqueuing(ticket EnqueueTicket, enqueueType proto.ForcedTicketEnqueueType) {
+	e.requestsLock.Lock()
+	defer e.requestsLock.Unlock()
+	ticket.ForceEnqueuing(enqueueType)
+	e.persistTicketsNoMutex()
+}
+
+func (e *EnqueueManager) ticketWithPaymentAccount(address string) (string, EnqueueTicket) {
+	e.requestsLock.RLock()
+	defer e.requestsLock.RUnlock()
+	for id, request := range e.requests {
+		if request.PaymentAccount().Address() == address {
+			return id, request
+		}
+	}
+	return "", nil
+}
+
+func (e *EnqueueManager) watchPaymentAccount(account *wallet.Account) {
+	if e.confirmations != nil {
+		e.confirmations.Watch(account.Address(), e.paymentsToCheck)
+	}
+}
+
+func (e *EnqueueManager) unwatchPaymentAccount(account *wallet.Account) {
+	if e.confirmations != nil {
+		e.confirmations.Unwatch(account.Address(), e.paymentsToCheck)
+	}
+}
+
 func (e *EnqueueManager) GetTicket(id string) EnqueueTicket {
 	e.requestsLock.RLock()
 	defer e.requestsLock.RUnlock()
 	return e.requests[id]
 }
 
+// PendingTicketsRequestedBy returns how many queue entries would result from the active tickets, pending payment,
+// that were requested by the specified user, and the total length of the media in those tickets
+func (e *EnqueueManager) PendingTicketsRequestedBy(user User) (int, time.Duration) {
+	e.requestsLock.RLock()
+	defer e.requestsLock.RUnlock()
+	count := 0
+	length := time.Duration(0)
+	for _, t := range e.requests {
+		requestedBy := t.RequestedBy()
+		if requestedBy == nil || requestedBy.IsUnknown() || requestedBy.Address() != user.Address() ||
+			t.Status() != proto.EnqueueMediaTicketStatus_ACTIVE {
+			continue
+		}
+		count += mediaItemCount(t.MediaInfo())
+		length += t.MediaInfo().Length()
+	}
+	return count, length
+}
+
 type ticket struct {
 	id             string
 	paid           bool
@@ -337,6 +666,11 @@ type ticket struct {
 	pricing        EnqueuePricing
 	statusChanged  *event.Event
 	forceEnqueuing *proto.ForcedTicketEnqueueType
+	// crowdfundingTargetTier is only set for crowdfunded tickets
+	crowdfundingTargetTier *proto.ForcedTicketEnqueueType
+	credit                 EnqueueCredit
+	voucherCode            string
+	voucherRedemptionID    string
 }
 
 func (t *ticket) Unskippable() bool {
@@ -355,6 +689,13 @@ func (t *ticket) CreatedAt() time.Time {
 	return t.createdAt
 }
 
+func (t *ticket) Expiration() time.Time {
+	if t.crowdfundingTargetTier != nil {
+		return t.createdAt.Add(CrowdfundedTicketExpiration)
+	}
+	return t.createdAt.Add(TicketExpiration)
+}
+
 func (t *ticket) RequestedBy() User {
 	return t.requestedBy
 }
@@ -371,9 +712,13 @@ func (t *ticket) SerializeForAPI() *proto.EnqueueMediaTicket {
 		EnqueuePrice:   t.pricing.EnqueuePrice.SerializeForAPI(),
 		PlayNextPrice:  t.pricing.PlayNextPrice.SerializeForAPI(),
 		PlayNowPrice:   t.pricing.PlayNowPrice.SerializeForAPI(),
-		Expiration:     timestamppb.New(t.CreatedAt().Add(TicketExpiration)),
+		Expiration:     timestamppb.New(t.Expiration()),
 		Unskippable:    t.unskippable,
 	}
+	serialized.Crowdfunded, serialized.CrowdfundingTargetTier = t.Crowdfunding()
+	serialized.CreditedTo = t.credit.creditedToAddress()
+	serialized.Anonymous = t.credit.Anonymous
+	serialized.VoucherCode = t.voucherCode
 	t.mediaInfo.FillAPITicketMediaInfo(serialized)
 	return serialized
 }
@@ -395,7 +740,7 @@ func (t *ticket) Status() proto.EnqueueMediaTicketStatus {
 	switch {
 	case t.paid:
 		return proto.EnqueueMediaTicketStatus_PAID
-	case time.Now().After(t.CreatedAt().Add(TicketExpiration)):
+	case time.Now().After(t.Expiration()):
 		return proto.EnqueueMediaTicketStatus_EXPIRED
 	default:
 		return proto.EnqueueMediaTicketStatus_ACTIVE
@@ -410,6 +755,21 @@ func (t *ticket) ForceEnqueuing(et proto.ForcedTicketEnqueueType) {
 	t.forceEnqueuing = &et
 }
 
+func (t *ticket) Crowdfunding() (bool, proto.ForcedTicketEnqueueType) {
+	if t.crowdfundingTargetTier != nil {
+		return true, *t.crowdfundingTargetTier
+	}
+	return false, 0
+}
+
+func (t *ticket) Credit() EnqueueCredit {
+	return t.credit
+}
+
+func (t *ticket) VoucherRedemptionID() string {
+	return t.voucherRedemptionID
+}
+
 func (t *ticket) EnqueuingForced() (bool, proto.ForcedTicketEnqueueType) {
 	if t.forceEnqueuing != nil {
 		return true, *t.forceEnqueuing
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/utils/event"
+)
+
+// ticketsFileFormatVersion is the version of the format written by persistTicketsNoMutex
+const ticketsFileFormatVersion = 1
+
+type ticketsFileContents struct {
+	Version int
+	Tickets []ticketFileContents
+}
+
+type ticketFileContents struct {
+	ID          string
+	CreatedAt   time.Time
+	RequestedBy string
+	Unskippable bool
+	// MediaInfo is in the format of the queue file entries, or of the playlist for playlists
+	MediaInfo           json.RawMessage
+	EnqueuePrice        *big.Int
+	PlayNextPrice       *big.Int
+	PlayNowPrice        *big.Int
+	PaymentAccountIndex uint32
+	ForcedEnqueueType   *proto.ForcedTicketEnqueueType `json:",omitempty"`
+	// CrowdfundingTargetTier is only present for crowdfunded tickets
+	CrowdfundingTargetTier *proto.ForcedTicketEnqueueType `json:",omitempty"`
+	CreditedTo             string                         `json:",omitempty"`
+	Anonymous              bool                           `json:",omitempty"`
+	VoucherCode            string                         `json:",omitempty"`
+	VoucherRedemptionID    string                         `json:",omitempty"`
+}
+
+// persistTicketsNoMutex writes the tickets that are yet to be paid to the persistence file, so that payments which
+// arrive around a restart can still be processed. Must be called with requestsLock held
+func (e *EnqueueManager) persistTicketsNoMutex() {
+	if e.persistenceFile == "" {
+		return
+	}
+	err := e.writeTicketsFile()
+	if err != nil {
+		e.log.Printf("error persisting tickets: %v", err)
+	}
+}
+
+func (e *EnqueueManager) writeTicketsFile() error {
+	contents := ticketsFileContents{
+		Version: ticketsFileFormatVersion,
+		Tickets: []ticketFileContents{},
+	}
+	for _, request := range e.requests {
+		t, ok := request.(*ticket)
+		if !ok || t.Status() == proto.EnqueueMediaTicketStatus_PAID {
+			continue
+		}
+		mediaInfo, err := t.mediaInfo.MarshalJSON()
+		if err != nil {
+			return stacktrace.Propagate(err, "error serializing media of ticket %s", t.id)
+		}
+		contents.Tickets = append(contents.Tickets, ticketFileContents{
+			ID:                     t.id,
+			CreatedAt:              t.createdAt,
+			RequestedBy:            t.requestedBy.Address(),
+			Unskippable:            t.unskippable,
+			MediaInfo:              mediaInfo,
+			EnqueuePrice:           t.pricing.EnqueuePrice.Int,
+			PlayNextPrice:          t.pricing.PlayNextPrice.Int,
+			PlayNowPrice:           t.pricing.PlayNowPrice.Int,
+			PaymentAccountIndex:    t.account.Index(),
+			ForcedEnqueueType:      t.forceEnqueuing,
+			CrowdfundingTargetTier: t.crowdfundingTargetTier,
+			CreditedTo:             t.credit.creditedToAddress(),
+			Anonymous:              t.credit.Anonymous,
+			VoucherCode:            t.voucherCode,
+			VoucherRedemptionID:    t.voucherRedemptionID,
+		})
+	}
+
+	marshalled, err := json.Marshal(contents)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing tickets")
+	}
+	tmpFile := e.persistenceFile + ".tmp"
+	err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error writing tickets to file")
+	}
+	return stacktrace.Propagate(os.Rename(tmpFile, e.persistenceFile), "error replacing tickets file")
+}
+
+// restoreTicketsFromFile loads the tickets that were pending payment when the server stopped.
+// Their payment accounts are checked again by ProcessPayments, even if they have expired in the meantime, so that
+// payments sent while the server was down are honored, or refunded when insufficient
+func (e *EnqueueManager) restoreTicketsFromFile() error {
+	b, err := ioutil.ReadFile(e.persistenceFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "error reading tickets from file")
+	}
+
+	var contents ticketsFileContents
+	err = json.Unmarshal(b, &contents)
+	if err != nil {
+		return stacktrace.Propagate(err, "error decoding tickets from file %s", e.persistenceFile)
+	}
+	if contents.Version > ticketsFileFormatVersion {
+		return stacktrace.NewError("tickets file format version %d is newer than the supported version %d",
+			contents.Version, ticketsFileFormatVersion)
+	}
+
+	e.requestsLock.Lock()
+	defer e.requestsLock.Unlock()
+	for _, tc := range contents.Tickets {
+		mediaInfo, err := decodeTicketMediaInfo(tc.MediaInfo)
+		if err != nil {
+			return stacktrace.Propagate(err, "error decoding media of ticket %s", tc.ID)
+		}
+		account, err := e.paymentAccountPool.AccountByIndex(tc.PaymentAccountIndex)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		t := &ticket{
+			id:          tc.ID,
+			createdAt:   tc.CreatedAt,
+			requestedBy: userFromPersistedAddress(tc.RequestedBy),
+			mediaInfo:   mediaInfo,
+			unskippable: tc.Unskippable,
+			pricing: EnqueuePricing{
+				EnqueuePrice:  Amount{tc.EnqueuePrice},
+				PlayNextPrice: Amount{tc.PlayNextPrice},
+				PlayNowPrice:  Amount{tc.PlayNowPrice},
+			},
+			account:                account,
+			statusChanged:          event.New(),
+			forceEnqueuing:         tc.ForcedEnqueueType,
+			crowdfundingTargetTier: tc.CrowdfundingTargetTier,
+			credit:                 enqueueCreditFromPersisted(tc.CreditedTo, tc.Anonymous),
+			voucherCode:            tc.VoucherCode,
+			voucherRedemptionID:    tc.VoucherRedemptionID,
+		}
+		if untilExpiry := time.Until(t.Expiration()); untilExpiry > 0 {
+			go func() {
+				<-time.NewTimer(untilExpiry).C
+				t.statusChanged.Notify()
+			}()
+		}
+		e.requests[t.id] = t
+		e.watchPaymentAccount(account)
+		e.log.Printf("Restored ticket %s with payment account %s", t.id, account.Address())
+	}
+	return nil
+}
+
+func decodeTicketMediaInfo(rawMediaInfo json.RawMessage) (MediaInfo, error) {
+	var typeOnly struct {
+		Type string
+	}
+	err := json.Unmarshal(rawMediaInfo, &typeOnly)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding media type")
+	}
+	if typeOnly.Type == mediaInfoTypeYouTubePlaylist {
+		playlist := &youTubePlaylist{}
+		err = playlist.UnmarshalJSON(rawMediaInfo)
+		return playlist, stacktrace.Propagate(err, "")
+	}
+	entry, err := decodeQueueEntry(rawMediaInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return entry.MediaInfo(), nil
+}
@@ -95,8 +95,31 @@ func (s *grpcServer) SignIn(r *proto.SignInRequest, stream proto.JungleTV_SignIn
 		return stacktrace.Propagate(err, "")
 	}
 
+	// changing the representative results in a confirmed block for the reward address, which lets us check right away
+	confirmed := make(chan ConfirmedBlock, 1)
+	if s.confirmationSubscriber != nil {
+		s.confirmationSubscriber.Watch(r.RewardAddress, confirmed)
+		defer s.confirmationSubscriber.Unwatch(r.RewardAddress, confirmed)
+	}
+
+	t := time.NewTicker(s.ticketCheckPeriod)
+	defer t.Stop()
+	lastChecked := time.Now()
 	for {
-		time.Sleep(s.ticketCheckPeriod)
+		select {
+		case <-t.C:
+			if s.confirmationSubscriber != nil && s.confirmationSubscriber.Connected() &&
+				time.Since(lastChecked) < confirmationFallbackCheckInterval && time.Now().Before(expiration) {
+				continue
+			}
+		case block := <-confirmed:
+			if block.Account != r.RewardAddress || block.Representative != verifRep.Address() {
+				continue
+			}
+		case <-ctx.Done():
+			return nil
+		}
+		lastChecked = time.Now()
 		if time.Now().After(expiration) {
 			err := stream.Send(&proto.SignInProgress{
 				Step: &proto.SignInProgress_Expired{
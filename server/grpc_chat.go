@@ -14,24 +14,29 @@ import (
 )
 
 func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.JungleTV_ConsumeChatServer) error {
-	onChatDisabled := s.chat.chatDisabled.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.chat.chatDisabled.Unsubscribe(onChatDisabled)
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	onChatDisabled := channel.chat.chatDisabled.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.chat.chatDisabled.Unsubscribe(onChatDisabled)
 
-	onChatEnabled := s.chat.chatEnabled.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.chat.chatEnabled.Unsubscribe(onChatEnabled)
+	onChatEnabled := channel.chat.chatEnabled.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.chat.chatEnabled.Unsubscribe(onChatEnabled)
 
-	onMessageCreated := s.chat.messageCreated.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.chat.messageCreated.Unsubscribe(onMessageCreated)
+	onMessageCreated := channel.chat.messageCreated.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.chat.messageCreated.Unsubscribe(onMessageCreated)
 
-	onMessageDeleted := s.chat.messageDeleted.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.chat.messageCreated.Unsubscribe(onMessageDeleted)
+	onMessageDeleted := channel.chat.messageDeleted.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.chat.messageCreated.Unsubscribe(onMessageDeleted)
 
 	heartbeatC := time.NewTicker(5 * time.Second).C
 	var seq uint32
 
 	user := UserClaimsFromContext(stream.Context())
 
-	chatEnabled, disabledReason := s.chat.Enabled()
+	chatEnabled, disabledReason := channel.chat.Enabled()
 	if chatEnabled {
 		initialHistorySize := r.InitialHistorySize
 		if initialHistorySize > 1000 {
@@ -41,7 +46,7 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 		if user != nil {
 			u = user
 		}
-		messages, err := s.chat.store.LoadNumLatestMessages(stream.Context(), u, int(initialHistorySize))
+		messages, err := channel.chat.store.LoadNumLatestMessages(stream.Context(), u, int(initialHistorySize))
 		if err != nil {
 			return stacktrace.Propagate(err, "failed to load chat messages")
 		}
@@ -135,10 +140,14 @@ func (s *grpcServer) SendChatMessage(ctx context.Context, r *proto.SendChatMessa
 	if len(r.Content) > 512 {
 		return nil, status.Error(codes.InvalidArgument, "message too long")
 	}
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
 
 	var messageReference *ChatMessage
 	if r.ReplyReferenceId != nil {
-		message, err := s.chat.LoadMessage(ctx, snowflake.ParseInt64(*r.ReplyReferenceId))
+		message, err := channel.chat.LoadMessage(ctx, snowflake.ParseInt64(*r.ReplyReferenceId))
 		if err == nil {
 			// use a copy of the referenced message without its reference in order to avoid long chains
 			messageReference = &ChatMessage{
@@ -150,7 +159,7 @@ func (s *grpcServer) SendChatMessage(ctx context.Context, r *proto.SendChatMessa
 		}
 	}
 
-	m, err := s.chat.CreateMessage(ctx, user, r.Content, messageReference)
+	m, err := channel.chat.CreateMessage(ctx, user, r.Content, messageReference)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -159,10 +168,10 @@ func (s *grpcServer) SendChatMessage(ctx context.Context, r *proto.SendChatMessa
 		go func() {
 			if r.Trusted {
 				if len(m.Content) >= 10 || m.Reference != nil {
-					s.rewardsHandler.MarkAddressAsActiveIfNotChallenged(ctx, user.Address())
+					channel.rewardsHandler.MarkAddressAsActiveIfNotChallenged(ctx, user.Address())
 				}
 			} else {
-				s.rewardsHandler.MarkAddressAsNotLegitimate(ctx, user.Address())
+				channel.rewardsHandler.MarkAddressAsNotLegitimate(ctx, user.Address())
 			}
 		}()
 	}
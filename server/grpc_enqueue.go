@@ -2,11 +2,14 @@ package server
 
 import (
 	"context"
+	"math/big"
 	"strings"
 
+	"github.com/hectorchu/gonano/util"
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *grpcServer) EnqueueMedia(ctx context.Context, r *proto.EnqueueMediaRequest) (*proto.EnqueueMediaResponse, error) {
@@ -24,7 +27,7 @@ func (s *grpcServer) EnqueueMedia(ctx context.Context, r *proto.EnqueueMediaRequ
 		}, nil
 	}
 
-	switch x := r.GetMediaInfo().(type) {
+	switch r.GetMediaInfo().(type) {
 	case *proto.EnqueueMediaRequest_StubData:
 		return &proto.EnqueueMediaResponse{
 			EnqueueResponse: &proto.EnqueueMediaResponse_Failure{
@@ -33,74 +36,305 @@ func (s *grpcServer) EnqueueMedia(ctx context.Context, r *proto.EnqueueMediaRequ
 				},
 			},
 		}, nil
-	case *proto.EnqueueMediaRequest_YoutubeVideoData:
-		return s.enqueueYouTubeVideo(ctx, r, x.YoutubeVideoData)
-	default:
+	case nil:
 		return nil, stacktrace.NewError("invalid media info type")
+	default:
+		return s.enqueueMedia(ctx, r)
 	}
 }
 
-func (s *grpcServer) enqueueYouTubeVideo(ctx context.Context, origReq *proto.EnqueueMediaRequest, r *proto.EnqueueYouTubeVideoData) (*proto.EnqueueMediaResponse, error) {
-	request, result, err := s.NewYouTubeVideoEnqueueRequest(ctx, r.Id, origReq.Unskippable)
+func (s *grpcServer) enqueueMedia(ctx context.Context, r *proto.EnqueueMediaRequest) (*proto.EnqueueMediaResponse, error) {
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, result, err := s.NewEnqueueRequest(ctx, channel, r)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	var failureReason string
+	if result != EnqueueRequestCreationSucceeded {
+		return &proto.EnqueueMediaResponse{
+			EnqueueResponse: &proto.EnqueueMediaResponse_Failure{
+				Failure: &proto.EnqueueMediaFailure{
+					FailureReason: enqueueRequestCreationFailureReason(result),
+				},
+			},
+		}, nil
+	}
 
-	switch result {
-	case youTubeVideoEnqueueRequestCreationSucceeded:
-		ticket, err := s.enqueueManager.RegisterRequest(ctx, request)
+	user := UserClaimsFromContext(ctx)
+	voucher, reason := s.checkVoucher(r, user, request)
+	if reason != "" {
+		return enqueueMediaFailure(reason), nil
+	}
+	if voucher != nil && voucher.IsFreeEnqueue() && (r.PayFromBalance || r.Crowdfunded) {
+		return enqueueMediaFailure("Free enqueue vouchers can't be combined with other payment methods"), nil
+	}
+
+	if r.PayFromBalance {
+		pricing := channel.enqueueManager.ComputePricing(ctx, request)
+		if voucher != nil {
+			pricing = voucher.ApplyToPricing(pricing)
+		}
+		reason := ""
+		switch {
+		case r.Crowdfunded:
+			reason = "Crowdfunded tickets can't be paid with a prepaid balance"
+		case s.prepaidBalances == nil:
+			reason = "Prepaid balances are not available"
+		case user == nil:
+			reason = "You must be signed in to pay with your prepaid balance"
+		case s.prepaidBalances.Balance(user).Cmp(priceOfTier(pricing, r.BalancePaymentTier).Int) < 0:
+			reason = "Your prepaid balance is insufficient"
+		}
+		if reason != "" {
+			return enqueueMediaFailure(reason), nil
+		}
+	}
+
+	options := TicketOptions{
+		Credit: EnqueueCredit{
+			Anonymous: r.Anonymous,
+		},
+	}
+	if r.Crowdfunded {
+		options.CrowdfundingTargetTier = &r.CrowdfundingTargetTier
+	}
+	if r.CreditedTo != "" && (user == nil || r.CreditedTo != user.Address()) {
+		_, err = util.AddressToPubkey(r.CreditedTo)
+		if err != nil || r.CreditedTo[:4] != "ban_" { // we must check for ban since AddressToPubkey accepts nano too
+			return enqueueMediaFailure("The address to credit is invalid"), nil
+		}
+		options.Credit.CreditedTo = NewAddressOnlyUser(r.CreditedTo)
+	}
+
+	redemptionID := ""
+	if voucher != nil {
+		// the voucher may have stopped being redeemable since it was checked
+		redeemed, id, err := s.vouchers.Redeem(r.VoucherCode, user, request.MediaInfo().Length())
+		if err != nil {
+			return enqueueMediaFailure(voucherFailureReason(err)), nil
+		}
+		options.Voucher = &redeemed
+		options.VoucherRedemptionID = id
+		redemptionID = id
+	}
+
+	ticket, err := channel.enqueueManager.RegisterRequest(ctx, request, options)
+	if err != nil {
+		if redemptionID != "" {
+			s.vouchers.CancelRedemption(redemptionID)
+		}
+		if strings.Contains(err.Error(), "failed to check balance for account") {
+			return enqueueMediaFailure("The JungleTV payment subsystem is unavailable"), nil
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if options.Voucher != nil {
+		s.vouchers.AssociateRedemptionWithTicket(redemptionID, ticket.ID())
+		s.log.Printf("Voucher %s redeemed by %s for ticket %s", options.Voucher.Code, user.Address(), ticket.ID())
+		if options.Voucher.IsFreeEnqueue() {
+			channel.enqueueManager.ForceEnqueuing(ticket, proto.ForcedTicketEnqueueType_ENQUEUE)
+			channel.enqueueManager.CheckTicket(ticket)
+		}
+	}
+
+	if r.PayFromBalance {
+		err = s.payTicketFromPrepaidBalance(channel, user, ticket, r.BalancePaymentTier)
 		if err != nil {
-			if strings.Contains(err.Error(), "failed to check balance for account") {
-				return &proto.EnqueueMediaResponse{
-					EnqueueResponse: &proto.EnqueueMediaResponse_Failure{
-						Failure: &proto.EnqueueMediaFailure{
-							FailureReason: "The JungleTV payment subsystem is unavailable",
-						},
-					},
-				}, nil
+			// the ticket can still be paid for as usual, or it will simply expire. The voucher must not count as
+			// redeemed meanwhile, as the enqueuing failed as far as the user is concerned
+			if redemptionID != "" {
+				s.vouchers.CancelRedemption(redemptionID)
+			}
+			reason := "Your prepaid balance is insufficient"
+			if stacktrace.RootCause(err) != ErrInsufficientPrepaidBalance {
+				s.log.Printf("error paying ticket %s from prepaid balance: %v", ticket.ID(), err)
+				reason = "The JungleTV payment subsystem is unavailable"
 			}
-			return nil, stacktrace.Propagate(err, "")
+			return enqueueMediaFailure(reason), nil
 		}
+	}
 
-		resp := ticket.SerializeForAPI()
-		currentEntry, playing := s.mediaQueue.CurrentlyPlaying()
-		resp.CurrentlyPlayingIsUnskippable = playing && currentEntry.Unskippable()
-		return &proto.EnqueueMediaResponse{
-			EnqueueResponse: &proto.EnqueueMediaResponse_Ticket{
-				Ticket: resp,
-			},
-		}, nil
-	case youTubeVideoEnqueueRequestCreationVideoNotFound:
-		failureReason = "Video not found"
-	case youTubeVideoEnqueueRequestCreationVideoAgeRestricted:
-		failureReason = "Video is age restricted"
-	case youTubeVideoEnqueueRequestCreationVideoIsLiveBroadcast:
-		failureReason = "Video is a live broadcast"
-	case youTubeVideoEnqueueRequestCreationVideoIsNotEmbeddable:
-		failureReason = "Video can't be played outside of YouTube"
-	case youTubeVideoEnqueueRequestCreationVideoIsTooLong:
-		failureReason = "Video is longer than 30 minutes"
-	case youTubeVideoEnqueueRequestCreationVideoIsAlreadyInQueue:
-		failureReason = "Video is already in the queue"
-	case youTubeVideoEnqueueRequestVideoEnqueuingDisabled:
-		failureReason = "Video enqueuing is currently disabled due to upcoming maintenance"
-	case youTubeVideoEnqueueRequestVideoEnqueuingStaffOnly:
-		failureReason = "At this moment, only JungleTV staff can enqueue videos"
+	return &proto.EnqueueMediaResponse{
+		EnqueueResponse: &proto.EnqueueMediaResponse_Ticket{
+			Ticket: channel.serializeTicketForAPI(ticket),
+		},
+	}, nil
+}
+
+func (s *grpcServer) GetEnqueuePricing(ctx context.Context, r *proto.EnqueueMediaRequest) (*proto.GetEnqueuePricingResponse, error) {
+	_, _, _, ok, err := s.pricingQuoteRateLimiter.Take(ctx, RemoteAddressFromContext(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
 	}
+	if !ok {
+		return enqueuePricingFailure("Rate limit reached"), nil
+	}
+
+	switch r.GetMediaInfo().(type) {
+	case *proto.EnqueueMediaRequest_StubData:
+		return enqueuePricingFailure("Enqueuing of stub media always fails"), nil
+	case nil:
+		return nil, stacktrace.NewError("invalid media info type")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	// go through the exact same validation as EnqueueMedia, but stop short of registering a ticket,
+	// so that no payment account is taken from the pool
+	request, result, err := s.NewEnqueueRequest(ctx, channel, r)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if result != EnqueueRequestCreationSucceeded {
+		return enqueuePricingFailure(enqueueRequestCreationFailureReason(result)), nil
+	}
+
+	voucher, reason := s.checkVoucher(r, UserClaimsFromContext(ctx), request)
+	if reason != "" {
+		return enqueuePricingFailure(reason), nil
+	}
+
+	pricing := channel.enqueueManager.ComputePricing(ctx, request)
+	if voucher != nil {
+		pricing = voucher.ApplyToPricing(pricing)
+	}
+	quote := &proto.EnqueuePricingQuote{
+		ChannelId:     channel.id,
+		EnqueuePrice:  pricing.EnqueuePrice.SerializeForAPI(),
+		PlayNextPrice: pricing.PlayNextPrice.SerializeForAPI(),
+		PlayNowPrice:  pricing.PlayNowPrice.SerializeForAPI(),
+		Unskippable:   request.Unskippable(),
+	}
+	if voucher != nil && voucher.IsFreeEnqueue() {
+		quote.EnqueuePrice = Amount{big.NewInt(0)}.SerializeForAPI()
+	}
+	currentEntry, playing := channel.mediaQueue.CurrentlyPlaying()
+	quote.CurrentlyPlayingIsUnskippable = playing && currentEntry.Unskippable()
+	enqueueStart, playNextStart, playNowStart := channel.mediaQueue.ProjectNewEntryStartTimes(request.RequestedBy())
+	quote.EnqueueProjectedStart = timestamppb.New(enqueueStart)
+	quote.PlayNextProjectedStart = timestamppb.New(playNextStart)
+	quote.PlayNowProjectedStart = timestamppb.New(playNowStart)
+
+	return &proto.GetEnqueuePricingResponse{
+		PricingResponse: &proto.GetEnqueuePricingResponse_Quote{
+			Quote: quote,
+		},
+	}, nil
+}
+
+// checkVoucher returns the voucher specified in the request, if any, or the user-facing explanation for why it can't
+// be used. The voucher is not redeemed
+func (s *grpcServer) checkVoucher(r *proto.EnqueueMediaRequest, user *UserClaims, request EnqueueRequest) (*Voucher, string) {
+	if r.VoucherCode == "" {
+		return nil, ""
+	}
+	if user == nil {
+		return nil, "You must be signed in to use a voucher"
+	}
+	voucher, err := s.vouchers.Check(r.VoucherCode, user, request.MediaInfo().Length())
+	if err != nil {
+		return nil, voucherFailureReason(err)
+	}
+	return &voucher, ""
+}
 
+// voucherFailureReason returns the user-facing explanation for why a voucher could not be used
+func voucherFailureReason(err error) string {
+	switch stacktrace.RootCause(err) {
+	case ErrVoucherNotFound:
+		return "Invalid voucher code"
+	case ErrVoucherNotRedeemable:
+		return "This voucher has expired"
+	case ErrVoucherRedemptionLimitReached:
+		return "You have already used this voucher"
+	case ErrVoucherNotApplicable:
+		return "This voucher can't be used for media this long"
+	default:
+		return "Failed to apply voucher"
+	}
+}
+
+func enqueueMediaFailure(reason string) *proto.EnqueueMediaResponse {
 	return &proto.EnqueueMediaResponse{
 		EnqueueResponse: &proto.EnqueueMediaResponse_Failure{
 			Failure: &proto.EnqueueMediaFailure{
-				FailureReason: failureReason,
+				FailureReason: reason,
 			},
 		},
-	}, nil
+	}
+}
+
+func enqueuePricingFailure(reason string) *proto.GetEnqueuePricingResponse {
+	return &proto.GetEnqueuePricingResponse{
+		PricingResponse: &proto.GetEnqueuePricingResponse_Failure{
+			Failure: &proto.EnqueueMediaFailure{
+				FailureReason: reason,
+			},
+		},
+	}
+}
+
+// serializeTicketForAPI serializes the ticket along with the channel state that is relevant to its requester
+func (c *Channel) serializeTicketForAPI(ticket EnqueueTicket) *proto.EnqueueMediaTicket {
+	resp := ticket.SerializeForAPI()
+	resp.ChannelId = c.id
+	currentEntry, playing := c.mediaQueue.CurrentlyPlaying()
+	resp.CurrentlyPlayingIsUnskippable = playing && currentEntry.Unskippable()
+	enqueueStart, playNextStart, playNowStart := c.mediaQueue.ProjectNewEntryStartTimes(ticket.RequestedBy())
+	resp.EnqueueProjectedStart = timestamppb.New(enqueueStart)
+	resp.PlayNextProjectedStart = timestamppb.New(playNextStart)
+	resp.PlayNowProjectedStart = timestamppb.New(playNowStart)
+	return resp
+}
+
+// enqueueRequestCreationFailureReason returns the user-facing explanation for why an enqueue request was rejected
+func enqueueRequestCreationFailureReason(result EnqueueRequestCreationResult) string {
+	switch result {
+	case EnqueueRequestCreationFailedMediumNotFound:
+		return "Video not found"
+	case EnqueueRequestCreationFailedMediumAgeRestricted:
+		return "Video is age restricted"
+	case EnqueueRequestCreationFailedMediumIsLiveBroadcast:
+		return "Video is a live broadcast"
+	case EnqueueRequestCreationFailedMediumIsNotEmbeddable:
+		return "Video can't be played outside of YouTube"
+	case EnqueueRequestCreationFailedMediumIsTooLong:
+		return "Media is longer than 30 minutes"
+	case EnqueueRequestCreationFailedMediumIsAlreadyInQueue:
+		return "Media is already in the queue"
+	case EnqueueRequestCreationFailedMediumIsInvalid:
+		return "Media information is invalid"
+	case EnqueueRequestCreationFailedMediumSegmentIsInvalid:
+		return "The start and end offsets must be within the video and the start must come before the end"
+	case EnqueueRequestCreationFailedMediumHostNotAllowed:
+		return "Media must be served by a JungleTV-approved host"
+	case EnqueueRequestCreationFailedMediumTypeNotSupported:
+		return "This type of media can't be enqueued"
+	case EnqueueRequestCreationFailedEnqueuingDisabled:
+		return "Video enqueuing is currently disabled due to upcoming maintenance"
+	case EnqueueRequestCreationFailedEnqueuingStaffOnly:
+		return "At this moment, only JungleTV staff can enqueue videos"
+	case EnqueueRequestCreationFailedMediumPlayedTooRecently:
+		return "Media was played too recently"
+	case EnqueueRequestCreationFailedMediumHasNoPlayableItems:
+		return "None of the videos in the playlist can be enqueued"
+	case EnqueueRequestCreationFailedRequesterQuotaExceeded:
+		return "You have reached the limit of media you can have in the queue. Wait for some of it to play before enqueuing more"
+	default:
+		return "Failed to enqueue media"
+	}
 }
 
 func (s *grpcServer) MonitorTicket(r *proto.MonitorTicketRequest, stream proto.JungleTV_MonitorTicketServer) error {
-	ticket := s.enqueueManager.GetTicket(r.TicketId)
+	channel, ticket := s.ticket(r.TicketId)
 	if ticket == nil {
 		err := stream.Send(&proto.EnqueueMediaTicket{
 			Id:     r.TicketId,
@@ -109,8 +343,12 @@ func (s *grpcServer) MonitorTicket(r *proto.MonitorTicketRequest, stream proto.J
 		return stacktrace.Propagate(err, "")
 	}
 
-	onMediaChanged := s.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.mediaQueue.mediaChanged.Unsubscribe(onMediaChanged)
+	onMediaChanged := channel.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.mediaQueue.mediaChanged.Unsubscribe(onMediaChanged)
+
+	// the projected start times change along with the queue
+	onQueueChanged := channel.mediaQueue.queueUpdated.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.mediaQueue.queueUpdated.Unsubscribe(onQueueChanged)
 
 	c := ticket.StatusChanged().Subscribe(event.AtLeastOnceGuarantee)
 	defer ticket.StatusChanged().Unsubscribe(c)
@@ -118,17 +356,15 @@ func (s *grpcServer) MonitorTicket(r *proto.MonitorTicketRequest, stream proto.J
 		select {
 		case <-onMediaChanged:
 			break
+		case <-onQueueChanged:
+			break
 		case <-c:
 			break
 		case <-stream.Context().Done():
 			return nil
 		}
 
-		response := ticket.SerializeForAPI()
-		currentEntry, playing := s.mediaQueue.CurrentlyPlaying()
-		response.CurrentlyPlayingIsUnskippable = playing && currentEntry.Unskippable()
-
-		if err := stream.Send(response); err != nil {
+		if err := stream.Send(channel.serializeTicketForAPI(ticket)); err != nil {
 			return stacktrace.Propagate(err, "")
 		}
 		if ticket.Status() == proto.EnqueueMediaTicketStatus_EXPIRED {
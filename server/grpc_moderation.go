@@ -2,14 +2,20 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
+	"github.com/hectorchu/gonano/util"
 	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
 	"github.com/tnyim/jungletv/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *grpcServer) ForciblyEnqueueTicket(ctx context.Context, r *proto.ForciblyEnqueueTicketRequest) (*proto.ForciblyEnqueueTicketResponse, error) {
@@ -19,11 +25,11 @@ func (s *grpcServer) ForciblyEnqueueTicket(ctx context.Context, r *proto.Forcibl
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	ticket := s.enqueueManager.GetTicket(r.Id)
+	channel, ticket := s.ticket(r.Id)
 	if ticket == nil {
 		return nil, stacktrace.NewError("unknown ticket ID")
 	}
-	ticket.ForceEnqueuing(r.EnqueueType)
+	channel.enqueueManager.ForceEnqueuing(ticket, r.EnqueueType)
 
 	s.log.Printf("Ticket %s forcibly enqueued by %s (remote address %s)", r.Id, user.Username, RemoteAddressFromContext(ctx))
 	return &proto.ForciblyEnqueueTicketResponse{}, nil
@@ -36,7 +42,12 @@ func (s *grpcServer) RemoveQueueEntry(ctx context.Context, r *proto.RemoveQueueE
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	entry, err := s.mediaQueue.RemoveEntry(r.Id)
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := channel.mediaQueue.RemoveEntry(r.Id)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to remove queue entry")
 	}
@@ -49,8 +60,8 @@ func (s *grpcServer) RemoveQueueEntry(ctx context.Context, r *proto.RemoveQueueE
 	}
 
 	_, err = s.modLogWebhook.SendContent(
-		fmt.Sprintf("Moderator %s (%s) removed queue entry requested by %s with title \"%s\"",
-			user.Address()[:14], user.Username, requestedBy, entry.MediaInfo().Title()))
+		fmt.Sprintf("Moderator %s (%s) removed queue entry requested by %s with title \"%s\"%s",
+			user.Address()[:14], user.Username, requestedBy, entry.MediaInfo().Title(), channel.forModLog()))
 	if err != nil {
 		s.log.Println("Failed to send mod log webhook:", err)
 	}
@@ -58,6 +69,94 @@ func (s *grpcServer) RemoveQueueEntry(ctx context.Context, r *proto.RemoveQueueE
 	return &proto.RemoveQueueEntryResponse{}, nil
 }
 
+func (s *grpcServer) MoveQueueEntry(ctx context.Context, r *proto.MoveQueueEntryRequest) (*proto.MoveQueueEntryResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry MediaQueueEntry
+	var from, to int
+	switch r.Movement {
+	case proto.QueueEntryMovement_MOVE_UP:
+		entry, from, to, err = channel.mediaQueue.MoveEntryBy(r.Id, -1, r.Pin)
+	case proto.QueueEntryMovement_MOVE_DOWN:
+		entry, from, to, err = channel.mediaQueue.MoveEntryBy(r.Id, 1, r.Pin)
+	case proto.QueueEntryMovement_MOVE_TO_POSITION:
+		entry, from, to, err = channel.mediaQueue.MoveEntryTo(r.Id, int(r.Position), r.Pin)
+	case proto.QueueEntryMovement_SWAP:
+		if r.Pin {
+			return nil, status.Error(codes.InvalidArgument, "swapped entries can't be pinned")
+		}
+		var otherEntry MediaQueueEntry
+		entry, otherEntry, from, to, err = channel.mediaQueue.SwapEntries(r.Id, r.SwapWithId)
+		if err == nil {
+			s.log.Printf("Queue entries with IDs %s and %s swapped by %s (remote address %s)", r.Id, r.SwapWithId, user.Username, RemoteAddressFromContext(ctx))
+			s.sendModLogQueueEntrySwapped(user, channel, entry, otherEntry)
+			return &proto.MoveQueueEntryResponse{
+				PreviousPosition: uint32(from),
+				NewPosition:      uint32(to),
+			}, nil
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid movement")
+	}
+	if err != nil {
+		if stacktrace.RootCause(err) == ErrQueueEntryNotMoved {
+			return nil, status.Error(codes.InvalidArgument, ErrQueueEntryNotMoved.Error())
+		}
+		return nil, stacktrace.Propagate(err, "failed to move queue entry")
+	}
+
+	s.log.Printf("Queue entry with ID %s moved from position %d to %d by %s (remote address %s)", r.Id, from, to, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		pinnedString := ""
+		if r.Pin {
+			pinnedString = " and pinned it there"
+		}
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) moved queue entry requested by %s with title \"%s\" from position %d to %d%s%s",
+				user.Address()[:14], user.Username, queueEntryRequesterForModLog(entry), entry.MediaInfo().Title(), from, to, pinnedString,
+				channel.forModLog()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.MoveQueueEntryResponse{
+		PreviousPosition: uint32(from),
+		NewPosition:      uint32(to),
+	}, nil
+}
+
+func (s *grpcServer) sendModLogQueueEntrySwapped(user *UserClaims, channel *Channel, entry1, entry2 MediaQueueEntry) {
+	if s.modLogWebhook == nil {
+		return
+	}
+	_, err := s.modLogWebhook.SendContent(
+		fmt.Sprintf("Moderator %s (%s) swapped queue entry requested by %s with title \"%s\" with queue entry requested by %s with title \"%s\"%s",
+			user.Address()[:14], user.Username,
+			queueEntryRequesterForModLog(entry1), entry1.MediaInfo().Title(),
+			queueEntryRequesterForModLog(entry2), entry2.MediaInfo().Title(), channel.forModLog()))
+	if err != nil {
+		s.log.Println("Failed to send mod log webhook:", err)
+	}
+}
+
+func queueEntryRequesterForModLog(entry MediaQueueEntry) string {
+	if entry.RequestedBy() != nil && !entry.RequestedBy().IsUnknown() {
+		return entry.RequestedBy().Address()[:14]
+	}
+	return "(unknown)"
+}
+
 func (s *grpcServer) RemoveChatMessage(ctx context.Context, r *proto.RemoveChatMessageRequest) (*proto.RemoveChatMessageResponse, error) {
 	user := UserClaimsFromContext(ctx)
 	if user == nil {
@@ -65,15 +164,20 @@ func (s *grpcServer) RemoveChatMessage(ctx context.Context, r *proto.RemoveChatM
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	deletedMsg, err := s.chat.DeleteMessage(ctx, snowflake.ParseInt64(r.Id))
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedMsg, err := channel.chat.DeleteMessage(ctx, snowflake.ParseInt64(r.Id))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
 	if s.modLogWebhook != nil {
 		_, err = s.modLogWebhook.SendContent(
-			fmt.Sprintf("Moderator %s (%s) deleted chat message from %s:\n\n>>> %s",
-				user.Address()[:14], user.Username, deletedMsg.Author.Address()[:14], deletedMsg.Content))
+			fmt.Sprintf("Moderator %s (%s) deleted chat message from %s%s:\n\n>>> %s",
+				user.Address()[:14], user.Username, deletedMsg.Author.Address()[:14], channel.forModLog(), deletedMsg.Content))
 		if err != nil {
 			s.log.Println("Failed to send mod log webhook:", err)
 		}
@@ -88,16 +192,21 @@ func (s *grpcServer) SetChatSettings(ctx context.Context, r *proto.SetChatSettin
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
 	enabledString := ""
 	if r.Enabled {
 		enabledString = "enabled"
-		s.chat.EnableChat()
+		channel.chat.EnableChat()
 	} else {
 		enabledString = "disabled"
-		s.chat.DisableChat(ChatDisabledReasonUnspecified)
+		channel.chat.DisableChat(ChatDisabledReasonUnspecified)
 	}
 
-	s.chat.SetSlowModeEnabled(r.Slowmode)
+	channel.chat.SetSlowModeEnabled(r.Slowmode)
 
 	slowmodeString := "disabled"
 	if r.Slowmode {
@@ -105,9 +214,9 @@ func (s *grpcServer) SetChatSettings(ctx context.Context, r *proto.SetChatSettin
 	}
 
 	if s.modLogWebhook != nil {
-		_, err := s.modLogWebhook.SendContent(
-			fmt.Sprintf("Moderator %s (%s) changed chat settings: chat %s, slowmode %s",
-				user.Address()[:14], user.Username, enabledString, slowmodeString))
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) changed chat settings%s: chat %s, slowmode %s",
+				user.Address()[:14], user.Username, channel.forModLog(), enabledString, slowmodeString))
 		if err != nil {
 			s.log.Println("Failed to send mod log webhook:", err)
 		}
@@ -123,12 +232,17 @@ func (s *grpcServer) SetVideoEnqueuingEnabled(ctx context.Context, r *proto.SetV
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	s.allowVideoEnqueuing = r.Allowed
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	channel.allowVideoEnqueuing = r.Allowed
 
 	if s.modLogWebhook != nil {
-		_, err := s.modLogWebhook.SendContent(
-			fmt.Sprintf("Moderator %s (%s) changed video enqueuing to %s",
-				user.Address()[:14], user.Username, r.Allowed.String()))
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) changed video enqueuing%s to %s",
+				user.Address()[:14], user.Username, channel.forModLog(), r.Allowed.String()))
 		if err != nil {
 			s.log.Println("Failed to send mod log webhook:", err)
 		}
@@ -137,6 +251,408 @@ func (s *grpcServer) SetVideoEnqueuingEnabled(ctx context.Context, r *proto.SetV
 	return &proto.SetVideoEnqueuingEnabledResponse{}, nil
 }
 
+func (s *grpcServer) SetPlaybackPaused(ctx context.Context, r *proto.SetPlaybackPausedRequest) (*proto.SetPlaybackPausedResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !channel.mediaQueue.SetPaused(r.Paused) {
+		return &proto.SetPlaybackPausedResponse{}, nil
+	}
+
+	action := "resumed"
+	if r.Paused {
+		action = "paused"
+	}
+	s.log.Printf("Playback of channel %s %s by %s (remote address %s)", channel.id, action, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) %s playback%s", user.Address()[:14], user.Username, action, channel.forModLog()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetPlaybackPausedResponse{}, nil
+}
+
+func (s *grpcServer) ScheduleMedia(ctx context.Context, r *proto.ScheduleMediaRequest) (*proto.ScheduleMediaResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Media == nil || r.StartsAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing media or start time")
+	}
+	startsAt := r.StartsAt.AsTime()
+	if !startsAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "start time must be in the future")
+	}
+
+	channel, err := s.channel(r.Media.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, result, err := s.NewEnqueueRequest(ctx, channel, r.Media)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if result != EnqueueRequestCreationSucceeded {
+		return nil, status.Error(codes.InvalidArgument, enqueueRequestCreationFailureReason(result))
+	}
+
+	slot := &ScheduledSlot{
+		ID:        uuid.NewV4().String(),
+		StartsAt:  startsAt,
+		Interrupt: r.Interrupt,
+	}
+	// scheduled media is not paid for, so there is nothing to distribute among spectators when it finishes playing
+	slot.Entries = request.MediaInfo().ProduceMediaQueueEntries(
+		request.RequestedBy(), Amount{big.NewInt(0)}, request.Unskippable(), slot.ID, nil, EnqueueCredit{})
+	err = channel.mediaQueue.Schedule(slot)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Slot %s scheduled for %s by %s (remote address %s)", slot.ID, startsAt, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) scheduled \"%s\" to play at %s%s",
+				user.Address()[:14], user.Username, request.MediaInfo().Title(),
+				startsAt.UTC().Format("2006-01-02 15:04:05 MST"), channel.forModLog()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ScheduleMediaResponse{
+		Id: slot.ID,
+	}, nil
+}
+
+func (s *grpcServer) UnscheduleMedia(ctx context.Context, r *proto.UnscheduleMediaRequest) (*proto.UnscheduleMediaResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := channel.mediaQueue.Unschedule(r.Id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to unschedule media")
+	}
+
+	s.log.Printf("Slot %s unscheduled by %s (remote address %s)", r.Id, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) unscheduled \"%s\", which was set to play at %s%s",
+				user.Address()[:14], user.Username, slot.Entries[0].MediaInfo().Title(),
+				slot.StartsAt.UTC().Format("2006-01-02 15:04:05 MST"), channel.forModLog()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.UnscheduleMediaResponse{}, nil
+}
+
+func (s *grpcServer) AutoEnqueueList(ctx context.Context, r *proto.AutoEnqueueListRequest) (*proto.AutoEnqueueListResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	list, unavailable := channel.autoEnqueue.List()
+	items, rotations := list.SerializeForAPI(unavailable)
+	return &proto.AutoEnqueueListResponse{
+		Items:     items,
+		Rotations: rotations,
+	}, nil
+}
+
+func (s *grpcServer) SetAutoEnqueueList(ctx context.Context, r *proto.SetAutoEnqueueListRequest) (*proto.SetAutoEnqueueListResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rotation := range r.Rotations {
+		if rotation.Start == nil || rotation.End == nil {
+			return nil, status.Error(codes.InvalidArgument, "rotations must have a start and an end")
+		}
+	}
+	list := autoEnqueueListFromAPI(r.Items, r.Rotations)
+	if err := list.validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+	}
+	err = channel.autoEnqueue.SetList(list)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Auto-enqueue list of channel %s changed by %s (remote address %s)", channel.id, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) changed the auto-enqueue list%s, which now has %d items and %d rotations",
+				user.Address()[:14], user.Username, channel.forModLog(), len(list.Items), len(list.Rotations)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetAutoEnqueueListResponse{}, nil
+}
+
+func (s *grpcServer) PricingStrategy(ctx context.Context, r *proto.PricingStrategyRequest) (*proto.PricingStrategyResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	strategy, parameters, err := s.pricer.Strategy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.PricingStrategyResponse{
+		Strategy:            strategy,
+		Parameters:          string(parameters),
+		AvailableStrategies: PricingStrategyNames(),
+	}, nil
+}
+
+func (s *grpcServer) SetPricingStrategy(ctx context.Context, r *proto.SetPricingStrategyRequest) (*proto.SetPricingStrategyResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	var parameters json.RawMessage
+	if strings.TrimSpace(r.Parameters) != "" {
+		parameters = json.RawMessage(r.Parameters)
+		if !json.Valid(parameters) {
+			return nil, status.Error(codes.InvalidArgument, "parameters must be valid JSON")
+		}
+	}
+
+	previousStrategy, previousParameters, err := s.pricer.Strategy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	err = s.pricer.SetStrategy(r.Strategy, parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+	}
+	_, newParameters, err := s.pricer.Strategy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Pricing strategy changed from %s to %s with parameters %s by %s (remote address %s)",
+		previousStrategy, r.Strategy, string(newParameters), user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) changed the pricing strategy from `%s` to `%s`\nPrevious parameters: `%s`\nNew parameters: `%s`",
+				user.Address()[:14], user.Username, previousStrategy, r.Strategy, string(previousParameters), string(newParameters)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetPricingStrategyResponse{}, nil
+}
+
+const defaultVoucherRedemptionsPageSize = 25
+const maxVoucherRedemptionsPageSize = 100
+
+func (s *grpcServer) MintVoucher(ctx context.Context, r *proto.MintVoucherRequest) (*proto.MintVoucherResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	voucher := Voucher{
+		Code:                  r.Code,
+		PercentageDiscount:    int64(r.PercentageDiscount),
+		MaxRedemptions:        int(r.MaxRedemptions),
+		MaxRedemptionsPerUser: int(r.MaxRedemptionsPerUser),
+		CreatedBy:             user.Address(),
+	}
+	for t, apiType := range VoucherTypesForAPI {
+		if apiType == r.Type {
+			voucher.Type = t
+		}
+	}
+	if r.FixedDiscount != "" {
+		var ok bool
+		voucher.FixedDiscount, ok = new(big.Int).SetString(r.FixedDiscount, 10)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid fixed discount")
+		}
+	}
+	if r.FreeEnqueueMaxLength != nil {
+		voucher.FreeEnqueueMaxLength = Duration(r.FreeEnqueueMaxLength.AsDuration())
+	}
+	if r.Expiration != nil {
+		expiresAt := r.Expiration.AsTime()
+		voucher.ExpiresAt = &expiresAt
+	}
+
+	voucher, err := s.vouchers.Mint(voucher)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+	}
+
+	s.log.Printf("Voucher %s (%s) minted by %s (remote address %s)", voucher.Code, voucher.Type, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) minted %s voucher `%s`",
+				user.Address()[:14], user.Username, voucher.Type, voucher.Code))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.MintVoucherResponse{
+		Voucher: voucher.SerializeForAPI(0),
+	}, nil
+}
+
+func (s *grpcServer) Vouchers(ctx context.Context, r *proto.VouchersRequest) (*proto.VouchersResponse, error) {
+	vouchers, redemptionCounts := s.vouchers.Vouchers(r.IncludeInactive)
+	response := &proto.VouchersResponse{
+		Vouchers: make([]*proto.Voucher, len(vouchers)),
+	}
+	for i := range vouchers {
+		response.Vouchers[i] = vouchers[i].SerializeForAPI(redemptionCounts[i])
+	}
+	return response, nil
+}
+
+func (s *grpcServer) RevokeVoucher(ctx context.Context, r *proto.RevokeVoucherRequest) (*proto.RevokeVoucherResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	voucher, err := s.vouchers.Revoke(r.Code)
+	if err != nil {
+		if stacktrace.RootCause(err) == ErrVoucherNotFound {
+			return nil, status.Error(codes.NotFound, "voucher not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Voucher %s revoked by %s (remote address %s)", voucher.Code, user.Username, RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) revoked voucher `%s`",
+				user.Address()[:14], user.Username, voucher.Code))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RevokeVoucherResponse{}, nil
+}
+
+func (s *grpcServer) VoucherRedemptions(ctx context.Context, r *proto.VoucherRedemptionsRequest) (*proto.VoucherRedemptionsResponse, error) {
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultVoucherRedemptionsPageSize
+	}
+	if limit > maxVoucherRedemptionsPageSize {
+		limit = maxVoucherRedemptionsPageSize
+	}
+
+	redemptions, total := s.vouchers.Redemptions(r.Code, int(r.Offset), limit)
+	response := &proto.VoucherRedemptionsResponse{
+		Redemptions: make([]*proto.VoucherRedemption, len(redemptions)),
+		Total:       uint32(total),
+	}
+	for i, redemption := range redemptions {
+		response.Redemptions[i] = &proto.VoucherRedemption{
+			Id:         redemption.ID,
+			Code:       redemption.Code,
+			RedeemedAt: timestamppb.New(redemption.RedeemedAt),
+			RedeemedBy: NewAddressOnlyUser(redemption.RedeemedBy).SerializeForAPI(),
+			TicketId:   redemption.TicketID,
+		}
+	}
+	return response, nil
+}
+
+func (s *grpcServer) RewardDistributions(ctx context.Context, r *proto.RewardDistributionsRequest) (*proto.RewardDistributionsResponse, error) {
+	if r.Address != "" {
+		_, err := util.AddressToPubkey(r.Address)
+		if err != nil || r.Address[:4] != "ban_" { // we must check for ban since AddressToPubkey accepts nano too
+			return nil, status.Error(codes.InvalidArgument, "invalid address")
+		}
+	}
+
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultRewardHistoryPageSize
+	}
+	if limit > maxRewardHistoryPageSize {
+		limit = maxRewardHistoryPageSize
+	}
+
+	distributions, total, err := s.rewardLedger.LoadRewardDistributions(ctx, r.Address, int(r.Offset), limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.RewardDistributionsResponse{
+		Distributions: make([]*proto.RewardDistribution, len(distributions)),
+		Total:         uint32(total),
+	}
+	for i, distribution := range distributions {
+		response.Distributions[i], err = distribution.SerializeForAPI()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return response, nil
+}
+
 func (s *grpcServer) BanUser(ctx context.Context, r *proto.BanUserRequest) (*proto.BanUserResponse, error) {
 	moderator := UserClaimsFromContext(ctx)
 	if moderator == nil {
@@ -156,8 +672,16 @@ func (s *grpcServer) BanUser(ctx context.Context, r *proto.BanUserRequest) (*pro
 		remoteAddresses = []string{r.RemoteAddress}
 	}
 
-	additionalRemoteAddresses := s.rewardsHandler.RemoteAddressesForRewardAddress(ctx, r.Address)
-	remoteAddresses = append(remoteAddresses, additionalRemoteAddresses...)
+	// the same user may be watching multiple channels from the same remote address
+	seenRemoteAddresses := make(map[string]struct{})
+	for _, channel := range s.channels {
+		for _, remoteAddress := range channel.rewardsHandler.RemoteAddressesForRewardAddress(ctx, r.Address) {
+			if _, seen := seenRemoteAddresses[remoteAddress]; !seen {
+				seenRemoteAddresses[remoteAddress] = struct{}{}
+				remoteAddresses = append(remoteAddresses, remoteAddress)
+			}
+		}
+	}
 
 	if len(remoteAddresses) == 0 {
 		// this way we'll add a single ban entry that only bans by reward address, but better than nothing
@@ -244,10 +768,15 @@ func (s *grpcServer) UserChatMessages(ctx context.Context, r *proto.UserChatMess
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
 	user := &addressOnlyUser{
 		address: r.Address,
 	}
-	messages, err := s.chat.store.LoadNumLatestMessagesFromUser(ctx, user, int(r.NumMessages))
+	messages, err := channel.chat.store.LoadNumLatestMessagesFromUser(ctx, user, int(r.NumMessages))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to load chat messages")
 	}
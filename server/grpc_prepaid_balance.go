@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultPrepaidBalanceStatementPageSize = 25
+const maxPrepaidBalanceStatementPageSize = 100
+
+var prepaidBalanceLedgerEntryTypesForAPI = map[BalanceLedgerEntryType]proto.PrepaidBalanceLedgerEntryType{
+	BalanceLedgerEntryTypeDeposit:       proto.PrepaidBalanceLedgerEntryType_DEPOSIT,
+	BalanceLedgerEntryTypeTicketPayment: proto.PrepaidBalanceLedgerEntryType_TICKET_PAYMENT,
+	BalanceLedgerEntryTypeWithdrawal:    proto.PrepaidBalanceLedgerEntryType_WITHDRAWAL,
+}
+
+func (s *grpcServer) PrepaidBalanceStatement(ctx context.Context, r *proto.PrepaidBalanceStatementRequest) (*proto.PrepaidBalanceStatementResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+	if s.prepaidBalances == nil {
+		return nil, status.Error(codes.FailedPrecondition, "prepaid balances are not enabled")
+	}
+
+	depositAddress, err := s.prepaidBalances.DepositAccount(user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	// make recent deposits show up without waiting for the deposit checker
+	err = s.prepaidBalances.CheckDeposits(user)
+	if err != nil {
+		s.log.Printf("error checking deposits of %s: %v", user.Address(), err)
+	}
+
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultPrepaidBalanceStatementPageSize
+	}
+	if limit > maxPrepaidBalanceStatementPageSize {
+		limit = maxPrepaidBalanceStatementPageSize
+	}
+
+	balance, entries, total := s.prepaidBalances.Statement(user, int(r.Offset), limit)
+	response := &proto.PrepaidBalanceStatementResponse{
+		DepositAddress: depositAddress,
+		Balance:        balance.SerializeForAPI(),
+		Entries:        make([]*proto.PrepaidBalanceLedgerEntry, len(entries)),
+		Total:          uint32(total),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = &proto.PrepaidBalanceLedgerEntry{
+			Id:        entry.ID,
+			At:        timestamppb.New(entry.At),
+			Type:      prepaidBalanceLedgerEntryTypesForAPI[entry.Type],
+			Amount:    entry.Amount.String(),
+			TicketId:  entry.TicketID,
+			BlockHash: entry.BlockHash,
+		}
+	}
+	return response, nil
+}
+
+func (s *grpcServer) WithdrawPrepaidBalance(ctx context.Context, r *proto.WithdrawPrepaidBalanceRequest) (*proto.WithdrawPrepaidBalanceResponse, error) {
+	user := UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+	if s.prepaidBalances == nil {
+		return nil, status.Error(codes.FailedPrecondition, "prepaid balances are not enabled")
+	}
+
+	amount := s.prepaidBalances.Balance(user).Int
+	if r.Amount != "" {
+		var ok bool
+		amount, ok = new(big.Int).SetString(r.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid amount")
+		}
+	}
+	if amount.Sign() <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "there is nothing to withdraw")
+	}
+
+	hash, err := s.prepaidBalances.Withdraw(user, amount)
+	if err != nil {
+		if stacktrace.RootCause(err) == ErrInsufficientPrepaidBalance {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient balance")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("%s withdrew %v from their prepaid balance (remote address %s)", user.Address(), amount, RemoteAddressFromContext(ctx))
+
+	return &proto.WithdrawPrepaidBalanceResponse{
+		Amount:    amount.String(),
+		BlockHash: hash,
+		Balance:   s.prepaidBalances.Balance(user).SerializeForAPI(),
+	}, nil
+}
+
+// payTicketFromPrepaidBalance pays the tier of the ticket requested by the user with their prepaid balance
+func (s *grpcServer) payTicketFromPrepaidBalance(channel *Channel, user User, ticket EnqueueTicket, tier proto.ForcedTicketEnqueueType) error {
+	hash, err := s.prepaidBalances.PayTicket(user, ticket, priceOfTier(ticket.RequestPricing(), tier))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	channel.enqueueManager.PaymentSent(ticket, hash)
+	return nil
+}
+
+func priceOfTier(pricing EnqueuePricing, tier proto.ForcedTicketEnqueueType) Amount {
+	switch tier {
+	case proto.ForcedTicketEnqueueType_PLAY_NEXT:
+		return pricing.PlayNextPrice
+	case proto.ForcedTicketEnqueueType_PLAY_NOW:
+		return pricing.PlayNowPrice
+	default:
+		return pricing.EnqueuePrice
+	}
+}
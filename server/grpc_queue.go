@@ -1,29 +1,80 @@
 package server
 
 import (
+	"context"
 	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.JungleTV_MonitorQueueServer) error {
-	getEntries := func() []*proto.QueueEntry {
-		entries := s.mediaQueue.Entries()
-		protoEntries := make([]*proto.QueueEntry, len(entries))
-		for i, entry := range entries {
-			protoEntries[i] = entry.SerializeForAPI()
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	getQueue := func() *proto.Queue {
+		// entries and schedule come from the same projection so that the projected start times are consistent
+		projection := channel.mediaQueue.ProjectStartTimes()
+		pinned := channel.mediaQueue.PinnedEntries()
+		queue := &proto.Queue{
+			Entries:        make([]*proto.QueueEntry, len(projection.Entries)),
+			ScheduledMedia: make([]*proto.ScheduledMedia, len(projection.Slots)),
+		}
+		for i, entry := range projection.Entries {
+			queue.Entries[i] = entry.SerializeForAPI()
+			queue.Entries[i].ProjectedStart = timestamppb.New(projection.EntryStarts[i])
+			_, queue.Entries[i].Pinned = pinned[entry.QueueID()]
+		}
+		for i, slot := range projection.Slots {
+			queue.ScheduledMedia[i] = slot.SerializeForAPI()
+			for j, entry := range queue.ScheduledMedia[i].Entries {
+				entry.ProjectedStart = timestamppb.New(projection.SlotStarts[i][j])
+			}
+		}
+		return queue
+	}
+
+	// in incremental mode, changes are computed against what was last sent to this client. Updates that happen
+	// while the client is slow to receive are coalesced, and a snapshot is sent when catching up is more expensive
+	var lastSent *proto.Queue
+	sequence := uint64(0)
+	sendUpdate := func() error {
+		queue := getQueue()
+		if !r.Incremental {
+			return stream.Send(queue)
+		}
+		sequence++
+		message := queue
+		if lastSent != nil {
+			if changes, ok := computeQueueChanges(lastSent.Entries, queue.Entries); ok {
+				message = &proto.Queue{
+					Changes: &proto.QueueChanges{
+						Changes:         changes,
+						ProjectedStarts: make([]*timestamppb.Timestamp, len(queue.Entries)),
+					},
+				}
+				for i, entry := range queue.Entries {
+					message.Changes.ProjectedStarts[i] = entry.ProjectedStart
+				}
+				if !scheduledMediaEqual(lastSent.ScheduledMedia, queue.ScheduledMedia) {
+					message.Changes.ScheduledMediaChanged = true
+					message.Changes.ScheduledMedia = queue.ScheduledMedia
+				}
+			}
 		}
-		return protoEntries
+		message.Sequence = sequence
+		lastSent = queue
+		return stream.Send(message)
 	}
 
-	onQueueChanged := s.mediaQueue.queueUpdated.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.mediaQueue.queueUpdated.Unsubscribe(onQueueChanged)
+	onQueueChanged := channel.mediaQueue.queueUpdated.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.mediaQueue.queueUpdated.Unsubscribe(onQueueChanged)
 
-	err := stream.Send(&proto.Queue{
-		Entries: getEntries(),
-	})
+	err = sendUpdate()
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -34,13 +85,11 @@ func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.Jun
 		var err error
 		select {
 		case <-onQueueChanged:
-			err = stream.Send(&proto.Queue{
-				IsHeartbeat: false,
-				Entries:     getEntries(),
-			})
+			err = sendUpdate()
 		case <-heartbeatC:
 			err = stream.Send(&proto.Queue{
 				IsHeartbeat: true,
+				Sequence:    sequence,
 			})
 		case <-stream.Context().Done():
 			return nil
@@ -50,3 +99,38 @@ func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.Jun
 		}
 	}
 }
+
+const defaultPlayedMediaHistoryPageSize = 25
+const maxPlayedMediaHistoryPageSize = 100
+
+func (s *grpcServer) PlayedMediaHistory(ctx context.Context, r *proto.PlayedMediaHistoryRequest) (*proto.PlayedMediaHistoryResponse, error) {
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultPlayedMediaHistoryPageSize
+	}
+	if limit > maxPlayedMediaHistoryPageSize {
+		limit = maxPlayedMediaHistoryPageSize
+	}
+
+	history, total, err := channel.playedMediaHistory.LoadPlayedMedia(ctx, int(r.Offset), limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.PlayedMediaHistoryResponse{
+		PlayedMedia: make([]*proto.PlayedMedia, len(history)),
+		Total:       uint32(total),
+	}
+	for i, p := range history {
+		response.PlayedMedia[i], err = p.SerializeForAPI()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return response, nil
+}
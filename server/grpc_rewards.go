@@ -7,6 +7,9 @@ import (
 	"github.com/tnyim/jungletv/proto"
 )
 
+const defaultRewardHistoryPageSize = 25
+const maxRewardHistoryPageSize = 100
+
 func (s *grpcServer) RewardInfo(ctx context.Context, r *proto.RewardInfoRequest) (*proto.RewardInfoResponse, error) {
 	userClaims := UserClaimsFromContext(ctx)
 	if userClaims == nil {
@@ -17,3 +20,40 @@ func (s *grpcServer) RewardInfo(ctx context.Context, r *proto.RewardInfoRequest)
 		RewardAddress: userClaims.RewardAddress,
 	}, nil
 }
+
+func (s *grpcServer) RewardHistory(ctx context.Context, r *proto.RewardHistoryRequest) (*proto.RewardHistoryResponse, error) {
+	userClaims := UserClaimsFromContext(ctx)
+	if userClaims == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	limit := int(r.Limit)
+	if limit == 0 {
+		limit = defaultRewardHistoryPageSize
+	}
+	if limit > maxRewardHistoryPageSize {
+		limit = maxRewardHistoryPageSize
+	}
+
+	distributions, total, err := s.rewardLedger.LoadRewardDistributions(ctx, userClaims.RewardAddress, int(r.Offset), limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	totalReceived, err := s.rewardLedger.LoadTotalRewarded(ctx, userClaims.RewardAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.RewardHistoryResponse{
+		Rewards:       make([]*proto.ReceivedReward, len(distributions)),
+		Total:         uint32(total),
+		TotalReceived: totalReceived.SerializeForAPI(),
+	}
+	for i, distribution := range distributions {
+		response.Rewards[i], err = distribution.SerializeForRecipientForAPI(userClaims.RewardAddress)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return response, nil
+}
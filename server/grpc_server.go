@@ -3,12 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"math/big"
 	"math/rand"
 	"net/http"
-	"strings"
 	"sync"
 	"time"
 
@@ -18,7 +15,6 @@ import (
 	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
-	"github.com/rickb777/date/period"
 	"github.com/sethvargo/go-limiter"
 	"github.com/sethvargo/go-limiter/memorystore"
 	"github.com/tnyim/jungletv/proto"
@@ -37,38 +33,72 @@ type grpcServer struct {
 	wallet                         *wallet.Wallet
 	collectorAccount               *wallet.Account
 	collectorAccountQueue          chan func(*wallet.Account, rpc.Client, rpc.Client)
+	refunder                       *Refunder
+	pricer                         *Pricer
+	revenueSplitter                *RevenueSplitter
+	rewardLedger                   RewardLedgerStore
+	vouchers                       *VoucherManager
+	confirmationSubscriber         *ConfirmationSubscriber
+	prepaidBalances                *PrepaidBalances
 	paymentAccountPendingWaitGroup *sync.WaitGroup
 	jwtManager                     *JWTManager
 	enqueueRequestRateLimiter      limiter.Store
+	pricingQuoteRateLimiter        limiter.Store
 	signInRateLimiter              limiter.Store
 	ipReputationChecker            *IPAddressReputationChecker
 
-	allowVideoEnqueuing      proto.AllowedVideoEnqueuingType
-	autoEnqueueVideos        bool
-	autoEnqueueVideoListFile string
-	ticketCheckPeriod        time.Duration
+	ticketCheckPeriod time.Duration
 
 	verificationProcesses *cache.Cache
 
-	mediaQueue      *MediaQueue
-	enqueueManager  *EnqueueManager
-	rewardsHandler  *RewardsHandler
-	statsHandler    *StatsHandler
-	chat            *ChatManager
+	// channels maps channel IDs to channels. The map is not modified after the server is created
+	channels        map[string]*Channel
 	workGenerator   *WorkGenerator
 	moderationStore ModerationStore
 
+	// mediaRepeatWindow is for how long media can't be enqueued again after it finishes playing
+	mediaRepeatWindow time.Duration
+
 	youtube       *youtube.Service
 	modLogWebhook api.WebhookClient
 }
 
-// NewServer returns a new JungleTVServer
+// ServerOptions contains the settings of the server
+type ServerOptions struct {
+	YouTubeAPIKey string
+	// Channels are the channels to serve. The first one is always the main channel
+	Channels               []ChannelConfig
+	BansFile               string
+	RepAddress             string
+	PaymentAccountPoolFile string
+	RefundPolicy           RefundPolicy
+	RefundLedgerFile       string
+	PricingFile            string
+	// NodeWebsocketURL is the websocket of the node used to be notified of confirmations. Optional
+	NodeWebsocketURL string
+	// PrepaidBalancesFile is where prepaid balances are kept. Prepaid balances are disabled if empty
+	PrepaidBalancesFile   string
+	RevenueSplit          RevenueSplit
+	RewardDustFile        string
+	VouchersFile          string
+	RewardLedgerFile      string
+	TicketCheckPeriod     time.Duration
+	IPCheckEndpoint       string
+	IPCheckToken          string
+	HCaptchaSecret        string
+	ModLogWebhook         string
+	DirectMediaHosts      []string
+	RequesterQuota        RequesterQuota
+	FairQueueInterleaving bool
+	MediaRepeatWindow     time.Duration
+}
+
+// NewServer returns a new JungleTVServer. The first of the channels is the main channel, whose ID is replaced by
+// MainChannelID
 func NewServer(ctx context.Context, log *log.Logger, statsClient *statsd.Client, w *wallet.Wallet,
-	youtubeAPIkey string, jwtManager *JWTManager, queueFile, bansFile, autoEnqueueVideoListFile, repAddress string,
-	ticketCheckPeriod time.Duration, ipCheckEndpoint, ipCheckToken string, hCaptchaSecret string, modLogWebhook string) (*grpcServer, error) {
-	mediaQueue, err := NewMediaQueue(ctx, log, statsClient, queueFile)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+	jwtManager *JWTManager, options ServerOptions) (*grpcServer, error) {
+	if len(options.Channels) == 0 {
+		return nil, stacktrace.NewError("at least one channel must be configured")
 	}
 
 	s := &grpcServer{
@@ -77,20 +107,19 @@ func NewServer(ctx context.Context, log *log.Logger, statsClient *statsd.Client,
 		statsClient:                    statsClient,
 		jwtManager:                     jwtManager,
 		verificationProcesses:          cache.New(5*time.Minute, 1*time.Minute),
-		mediaQueue:                     mediaQueue,
 		workGenerator:                  NewWorkGenerator(),
 		collectorAccountQueue:          make(chan func(*wallet.Account, rpc.Client, rpc.Client), 10000),
 		paymentAccountPendingWaitGroup: new(sync.WaitGroup),
-		autoEnqueueVideoListFile:       autoEnqueueVideoListFile,
-		autoEnqueueVideos:              autoEnqueueVideoListFile != "",
-		allowVideoEnqueuing:            proto.AllowedVideoEnqueuingType_ENABLED,
-		ipReputationChecker:            NewIPAddressReputationChecker(log, ipCheckEndpoint, ipCheckToken),
-		ticketCheckPeriod:              ticketCheckPeriod,
-		moderationStore:                NewModerationStoreMemory(bansFile),
+		ipReputationChecker:            NewIPAddressReputationChecker(log, options.IPCheckEndpoint, options.IPCheckToken),
+		ticketCheckPeriod:              options.TicketCheckPeriod,
+		moderationStore:                NewModerationStoreMemory(options.BansFile),
+		mediaRepeatWindow:              options.MediaRepeatWindow,
+		channels:                       make(map[string]*Channel),
 	}
 
-	if modLogWebhook != "" {
-		s.modLogWebhook, err = disgohook.NewWebhookClientByToken(nil, newSimpleLogger(log, false), modLogWebhook)
+	var err error
+	if options.ModLogWebhook != "" {
+		s.modLogWebhook, err = disgohook.NewWebhookClientByToken(nil, newSimpleLogger(log, false), options.ModLogWebhook)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "")
 		}
@@ -103,6 +132,14 @@ func NewServer(ctx context.Context, log *log.Logger, statsClient *statsd.Client,
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
+	// quotes don't reserve payment accounts, but still involve requests to the media providers
+	s.pricingQuoteRateLimiter, err = memorystore.New(&memorystore.Config{
+		Tokens:   30,
+		Interval: time.Minute,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 	s.signInRateLimiter, err = memorystore.New(&memorystore.Config{
 		Tokens:   10,
 		Interval: 5 * time.Minute,
@@ -113,81 +150,79 @@ func NewServer(ctx context.Context, log *log.Logger, statsClient *statsd.Client,
 
 	collectorAccountIdx := uint32(0)
 	s.collectorAccount, err = w.NewAccount(&collectorAccountIdx)
-	s.collectorAccount.SetRep(repAddress)
+	s.collectorAccount.SetRep(options.RepAddress)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.statsHandler, err = NewStatsHandler(log, s.mediaQueue, s.statsClient)
+	client := &http.Client{
+		Transport: &transport.APIKey{Key: options.YouTubeAPIKey},
+	}
+
+	s.youtube, err = youtube.New(client)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+		return nil, stacktrace.Propagate(err, "error creating YouTube client")
+	}
+
+	if options.NodeWebsocketURL != "" {
+		s.confirmationSubscriber = NewConfirmationSubscriber(log, options.NodeWebsocketURL)
 	}
 
-	s.enqueueManager, err = NewEnqueueManager(log, statsClient, s.mediaQueue, w, NewPaymentAccountPool(w, repAddress),
-		s.paymentAccountPendingWaitGroup, s.statsHandler, s.collectorAccount.Address(), s.moderationStore,
-		s.modLogWebhook)
+	// deposit accounts must be derived before any payment accounts, as otherwise the payment account pool could
+	// derive them as new accounts
+	if options.PrepaidBalancesFile != "" {
+		s.prepaidBalances, err = NewPrepaidBalances(log, w, options.RepAddress, options.PrepaidBalancesFile,
+			s.confirmationSubscriber)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	// payment accounts are derived from the shared wallet, so all channels must take them from the same pool
+	s.refunder = NewRefunder(log, statsClient, w, options.RefundPolicy, options.RefundLedgerFile)
+
+	s.pricer, err = NewPricer(options.PricingFile)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.rewardsHandler, err = NewRewardsHandler(
-		log, statsClient, s.mediaQueue, s.ipReputationChecker, hCaptchaSecret, w, s.collectorAccountQueue,
-		s.workGenerator, s.paymentAccountPendingWaitGroup, s.moderationStore)
+	// the collector account is shared by all channels, and so is the dust left in it
+	s.revenueSplitter, err = NewRevenueSplitter(log, options.RevenueSplit, options.RewardDustFile)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.chat, err = NewChatManager(log, statsClient, NewChatStoreMemory(10000), s.moderationStore)
+	s.vouchers, err = NewVoucherManager(log, options.VouchersFile)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	client := &http.Client{
-		Transport: &transport.APIKey{Key: youtubeAPIkey},
+	// the ledger is shared by all channels, so that the reward history of each user includes every channel
+	s.rewardLedger, err = NewRewardLedgerStoreMemory(options.RewardLedgerFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.youtube, err = youtube.New(client)
+	paymentAccountPool, err := NewPaymentAccountPool(log, w, options.RepAddress, options.PaymentAccountPoolFile)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "error creating YouTube client")
+		return nil, stacktrace.Propagate(err, "")
+	}
+	options.Channels[0].ID = MainChannelID
+	for _, config := range options.Channels {
+		if _, present := s.channels[config.ID]; present || config.ID == "" {
+			return nil, stacktrace.NewError("invalid or duplicate channel ID \"%s\"", config.ID)
+		}
+		s.channels[config.ID], err = s.newChannel(ctx, config, paymentAccountPool, options.HCaptchaSecret,
+			options.DirectMediaHosts, options.RequesterQuota, options.FairQueueInterleaving)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "error creating channel %s", config.ID)
+		}
 	}
 	return s, nil
 }
 
 func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 	errChan := make(chan error)
-	go func(ctx context.Context) {
-		for {
-			s.log.Println("Payments processor starting/restarting")
-			err := s.enqueueManager.ProcessPaymentsWorker(ctx, s.ticketCheckPeriod)
-			if err == nil {
-				return
-			}
-			errChan <- stacktrace.Propagate(err, "")
-			select {
-			case <-ctx.Done():
-				s.log.Println("Payments processor done")
-				return
-			default:
-			}
-		}
-	}(ctx)
-
-	go func(ctx context.Context) {
-		for {
-			s.log.Println("Rewards handler starting/restarting")
-			err := s.rewardsHandler.Worker(ctx)
-			if err == nil {
-				return
-			}
-			errChan <- stacktrace.Propagate(err, "")
-			select {
-			case <-ctx.Done():
-				s.log.Println("Rewards handler done")
-				return
-			default:
-			}
-		}
-	}(ctx)
 
 	go func(ctx context.Context) {
 		for {
@@ -201,101 +236,33 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}
 	}(ctx)
 
-	go s.mediaQueue.ProcessQueueWorker(ctx)
-	go s.ipReputationChecker.Worker(ctx)
-
-	go func() {
-		mediaChangedC := s.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
-		defer s.mediaQueue.mediaChanged.Unsubscribe(mediaChangedC)
-
-		entryAddedC := s.mediaQueue.entryAdded.Subscribe(event.AtLeastOnceGuarantee)
-		defer s.mediaQueue.entryAdded.Unsubscribe(entryAddedC)
-
-		rewardsDistributedC := s.rewardsHandler.rewardsDistributed.Subscribe(event.AtLeastOnceGuarantee)
-		defer s.rewardsHandler.rewardsDistributed.Unsubscribe(rewardsDistributedC)
+	if s.confirmationSubscriber != nil {
+		go s.confirmationSubscriber.Worker(ctx)
+	}
 
-		for {
-			select {
-			case v := <-mediaChangedC:
-				var err error
-				if v[0] == nil {
-					_, err = s.chat.CreateSystemMessage(ctx, "_The queue is now empty._")
-				} else {
-					title := v[0].(MediaQueueEntry).MediaInfo().Title()
-					_, err = s.chat.CreateSystemMessage(ctx, fmt.Sprintf("_Now playing:_ %s", title))
-				}
-				if err != nil {
-					errChan <- stacktrace.Propagate(err, "")
+	if s.prepaidBalances != nil {
+		go func(ctx context.Context) {
+			for {
+				s.log.Println("Prepaid balances deposit checker starting/restarting")
+				err := s.prepaidBalances.Worker(ctx, confirmationFallbackCheckInterval)
+				if err == nil {
+					return
 				}
-			case v := <-entryAddedC:
-				var err error
-				t := v[0].(string)
-				entry := v[1].(MediaQueueEntry)
-				if !entry.RequestedBy().IsUnknown() {
-					switch t {
-					case "enqueue":
-						_, err = s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
-							"_%s just enqueued_ %s", entry.RequestedBy().Address()[:14], entry.MediaInfo().Title()))
-					case "play_after_next":
-						_, err = s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
-							"_%s just set_ %s _to play after the current video_",
-							entry.RequestedBy().Address()[:14], entry.MediaInfo().Title()))
-					case "play_now":
-						_, err = s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
-							"_%s just skipped the previous video!_", entry.RequestedBy().Address()[:14]))
-					}
-					if err != nil {
-						errChan <- stacktrace.Propagate(err, "")
-					}
-				}
-			case v := <-rewardsDistributedC:
-				amount := v[0].(Amount)
-				eligibleCount := v[1].(int)
-				exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(29), nil)
-				banStr := new(big.Rat).SetFrac(amount.Int, exp).FloatString(2)
-
-				_, err := s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
-					"_**%s BAN** distributed among %d spectators._", banStr, eligibleCount))
-				if err != nil {
-					errChan <- stacktrace.Propagate(err, "")
+				errChan <- stacktrace.Propagate(err, "")
+				select {
+				case <-ctx.Done():
+					s.log.Println("Prepaid balances deposit checker done")
+					return
+				case <-time.After(s.ticketCheckPeriod):
 				}
-			case <-ctx.Done():
-				s.log.Println("Chat system message sender done")
-				return
 			}
-		}
-	}()
-
-	go func() {
-		mediaChangedC := s.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
-		defer s.mediaQueue.mediaChanged.Unsubscribe(mediaChangedC)
+		}(ctx)
+	}
 
-		wait := time.Duration(90+rand.Intn(180)) * time.Second
-		t := time.NewTimer(wait)
-		for {
-			select {
-			case v := <-mediaChangedC:
-				if v[0] == nil {
-					wait = time.Duration(90+rand.Intn(180)) * time.Second
-					t.Reset(wait)
-				}
-			case <-t.C:
-				if s.mediaQueue.Length() == 0 && s.autoEnqueueVideos &&
-					s.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_ENABLED {
-					for attempt := 0; attempt < 3; attempt++ {
-						err := s.autoEnqueueNewVideo(ctx)
-						if err != nil {
-							errChan <- stacktrace.Propagate(err, "")
-						} else {
-							wait = time.Duration(90+rand.Intn(180)) * time.Second
-							t.Reset(wait)
-							break
-						}
-					}
-				}
-			}
-		}
-	}()
+	for _, c := range s.channels {
+		s.channelWorker(ctx, c, errChan)
+	}
+	go s.ipReputationChecker.Worker(ctx)
 
 	for {
 		select {
@@ -307,129 +274,88 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 	}
 }
 
-func (s *grpcServer) autoEnqueueNewVideo(ctx context.Context) error {
-	videoID, err := s.getRandomVideoForAutoEnqueue()
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	request, result, err := s.NewYouTubeVideoEnqueueRequest(ctx, videoID, false)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
+func (s *grpcServer) autoEnqueueWorker(ctx context.Context, c *Channel, errChan chan<- error) {
+	mediaChangedC := c.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
+	defer c.mediaQueue.mediaChanged.Unsubscribe(mediaChangedC)
 
-	if result != youTubeVideoEnqueueRequestCreationSucceeded {
-		return stacktrace.NewError("enqueue request for video %s creation failed due to video characteristics", videoID)
-	}
-
-	ticket, err := s.enqueueManager.RegisterRequest(ctx, request)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	ticket.ForceEnqueuing(proto.ForcedTicketEnqueueType_ENQUEUE)
-	s.log.Printf("Auto-enqueued video with ID %s", videoID)
-	return nil
-}
-
-func (s *grpcServer) getRandomVideoForAutoEnqueue() (string, error) {
-	b, err := ioutil.ReadFile(s.autoEnqueueVideoListFile)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "error reading auto enqueue videos from file: %v", err)
+	wait := time.Duration(90+rand.Intn(180)) * time.Second
+	t := time.NewTimer(wait)
+	for {
+		select {
+		case v := <-mediaChangedC:
+			if v[0] == nil {
+				wait = time.Duration(90+rand.Intn(180)) * time.Second
+				t.Reset(wait)
+			}
+		case <-t.C:
+			if c.mediaQueue.Length() == 0 && c.autoEnqueue.Enabled() &&
+				c.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_ENABLED {
+				err := s.autoEnqueueNewVideo(ctx, c)
+				if err != nil {
+					errChan <- stacktrace.Propagate(err, "")
+				}
+				// if enqueuing failed, try again later rather than leaving the queue empty until someone enqueues
+				wait = time.Duration(90+rand.Intn(180)) * time.Second
+				t.Reset(wait)
+			}
+		case <-ctx.Done():
+			t.Stop()
+			return
+		}
 	}
-	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
-	line := lines[rand.Intn(len(lines))]
-	id := strings.TrimSpace(strings.Split(line, " ")[0])
-	return id, nil
 }
 
-type youTubeVideoEnqueueRequestCreationResult int
-
-const (
-	youTubeVideoEnqueueRequestCreationSucceeded youTubeVideoEnqueueRequestCreationResult = iota
-	youTubeVideoEnqueueRequestCreationFailed
-	youTubeVideoEnqueueRequestCreationVideoNotFound
-	youTubeVideoEnqueueRequestCreationVideoAgeRestricted
-	youTubeVideoEnqueueRequestCreationVideoIsLiveBroadcast
-	youTubeVideoEnqueueRequestCreationVideoIsNotEmbeddable
-	youTubeVideoEnqueueRequestCreationVideoIsTooLong
-	youTubeVideoEnqueueRequestCreationVideoIsAlreadyInQueue
-	youTubeVideoEnqueueRequestVideoEnqueuingDisabled
-	youTubeVideoEnqueueRequestVideoEnqueuingStaffOnly
-)
+// maxAutoEnqueueAttempts is how many different items are tried each time the queue needs to be filled
+const maxAutoEnqueueAttempts = 5
 
-func (s *grpcServer) NewYouTubeVideoEnqueueRequest(ctx context.Context, videoID string, unskippable bool) (EnqueueRequest, youTubeVideoEnqueueRequestCreationResult, error) {
-	isAdmin := false
-	user := UserClaimsFromContext(ctx)
-	if banned, err := s.moderationStore.LoadRemoteAddressBannedFromVideoEnqueuing(ctx, RemoteAddressFromContext(ctx)); err == nil && banned {
-		return nil, youTubeVideoEnqueueRequestVideoEnqueuingStaffOnly, nil
-	}
-	if user != nil {
-		isAdmin = permissionLevelOrder[user.PermLevel] >= permissionLevelOrder[AdminPermissionLevel]
-		if banned, err := s.moderationStore.LoadPaymentAddressBannedFromVideoEnqueuing(ctx, user.Address()); err == nil && banned {
-			return nil, youTubeVideoEnqueueRequestVideoEnqueuingStaffOnly, nil
+func (s *grpcServer) autoEnqueueNewVideo(ctx context.Context, c *Channel) error {
+	exclude := make(map[string]struct{})
+	for attempt := 0; attempt < maxAutoEnqueueAttempts; attempt++ {
+		item, ok := c.autoEnqueue.Pick(time.Now(), exclude)
+		if !ok {
+			return stacktrace.NewError("no items available for auto-enqueuing on channel %s", c.id)
+		}
+		exclude[item.ID] = struct{}{}
+
+		request, result, err := s.NewEnqueueRequest(ctx, c, &proto.EnqueueMediaRequest{
+			MediaInfo: &proto.EnqueueMediaRequest_YoutubeVideoData{
+				YoutubeVideoData: &proto.EnqueueYouTubeVideoData{
+					Id: item.ID,
+				},
+			},
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "")
 		}
-	}
-	if s.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_DISABLED {
-		return nil, youTubeVideoEnqueueRequestVideoEnqueuingDisabled, nil
-	}
-	if !isAdmin && s.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_STAFF_ONLY {
-		return nil, youTubeVideoEnqueueRequestVideoEnqueuingStaffOnly, nil
-	}
 
-	for _, entry := range s.mediaQueue.Entries() {
-		if ytEntry, ok := entry.(*queueEntryYouTubeVideo); ok {
-			if ytEntry.id == videoID {
-				return nil, youTubeVideoEnqueueRequestCreationVideoIsAlreadyInQueue, nil
+		switch result {
+		case EnqueueRequestCreationSucceeded:
+			ticket, err := c.enqueueManager.RegisterRequest(ctx, request, TicketOptions{})
+			if err != nil {
+				return stacktrace.Propagate(err, "")
 			}
+			c.enqueueManager.ForceEnqueuing(ticket, proto.ForcedTicketEnqueueType_ENQUEUE)
+			s.log.Printf("Auto-enqueued video with ID %s on channel %s", item.ID, c.id)
+			return nil
+		case EnqueueRequestCreationFailedMediumNotFound,
+			EnqueueRequestCreationFailedMediumAgeRestricted,
+			EnqueueRequestCreationFailedMediumIsLiveBroadcast,
+			EnqueueRequestCreationFailedMediumIsNotEmbeddable,
+			EnqueueRequestCreationFailedMediumIsTooLong,
+			EnqueueRequestCreationFailedMediumIsInvalid:
+			// the video won't become enqueuable by itself, stop picking it until the list is changed
+			reason := enqueueRequestCreationFailureReason(result)
+			s.log.Printf("Auto-enqueue item %s on channel %s is unavailable: %s", item.ID, c.id, reason)
+			if c.autoEnqueue.MarkUnavailable(item.ID, reason) && s.modLogWebhook != nil {
+				_, err = s.modLogWebhook.SendContent(
+					fmt.Sprintf("Auto-enqueue item `%s` is being skipped%s: %s", item.ID, c.forModLog(), reason))
+				if err != nil {
+					s.log.Println("Failed to send mod log webhook:", err)
+				}
+			}
+		default:
+			// e.g. the video is already in the queue or was played too recently, another item may work
 		}
 	}
-
-	response, err := s.youtube.Videos.List([]string{"snippet", "contentDetails", "status"}).Id(videoID).MaxResults(1).Do()
-	if err != nil {
-		return nil, youTubeVideoEnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
-	}
-
-	if len(response.Items) == 0 {
-		return nil, youTubeVideoEnqueueRequestCreationVideoNotFound, nil
-	}
-
-	videoItem := response.Items[0]
-	if videoItem.ContentDetails.ContentRating.YtRating == "ytAgeRestricted" {
-		return nil, youTubeVideoEnqueueRequestCreationVideoAgeRestricted, nil
-	}
-
-	if !videoItem.Status.Embeddable {
-		return nil, youTubeVideoEnqueueRequestCreationVideoIsNotEmbeddable, nil
-	}
-
-	if videoItem.Snippet.LiveBroadcastContent != "none" {
-		return nil, youTubeVideoEnqueueRequestCreationVideoIsLiveBroadcast, nil
-	}
-
-	videoDuration, err := period.Parse(videoItem.ContentDetails.Duration)
-	if err != nil {
-		return nil, youTubeVideoEnqueueRequestCreationFailed, stacktrace.Propagate(err, "error parsing video duration")
-	}
-
-	if videoDuration.DurationApprox() > 30*time.Minute {
-		return nil, youTubeVideoEnqueueRequestCreationVideoIsTooLong, nil
-	}
-
-	request := &queueEntryYouTubeVideo{
-		id:           videoID,
-		title:        videoItem.Snippet.Title,
-		channelTitle: videoItem.Snippet.ChannelTitle,
-		thumbnailURL: videoItem.Snippet.Thumbnails.Default.Url,
-		duration:     videoDuration.DurationApprox(),
-		donePlaying:  event.New(),
-		requestedBy:  &unknownUser{},
-		unskippable:  unskippable,
-	}
-
-	userClaims := UserClaimsFromContext(ctx)
-	if userClaims != nil {
-		request.requestedBy = userClaims
-	}
-
-	return request, youTubeVideoEnqueueRequestCreationSucceeded, nil
+	return stacktrace.NewError("failed to auto-enqueue on channel %s after %d attempts", c.id, maxAutoEnqueueAttempts)
 }
@@ -19,8 +19,13 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 		return stream.Send(cp)
 	}
 
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return err
+	}
+
 	user := UserClaimsFromContext(stream.Context())
-	err := stream.Send(s.produceMediaConsumptionCheckpoint(stream.Context()))
+	err = stream.Send(channel.produceMediaConsumptionCheckpoint(stream.Context()))
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -28,7 +33,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	errChan := make(chan error)
 
 	if user != nil {
-		spectator, err := s.rewardsHandler.RegisterSpectator(stream.Context(), user)
+		spectator, err := channel.rewardsHandler.RegisterSpectator(stream.Context(), user)
 		if err != nil {
 			return stacktrace.Propagate(err, "")
 		}
@@ -36,7 +41,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 		// SubscribeUsingCallback returns a function that unsubscribes when called. That's the reason for the defers
 
 		defer spectator.OnRewarded().SubscribeUsingCallback(event.AtLeastOnceGuarantee, func(reward Amount) {
-			cp := s.produceMediaConsumptionCheckpoint(stream.Context())
+			cp := channel.produceMediaConsumptionCheckpoint(stream.Context())
 			s := reward.String()
 			cp.Reward = &s
 			err := send(cp)
@@ -46,7 +51,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 		})()
 
 		defer spectator.OnActivityChallenge().SubscribeUsingCallback(event.AtLeastOnceGuarantee, func(challenge *activityChallenge) {
-			cp := s.produceMediaConsumptionCheckpoint(stream.Context())
+			cp := channel.produceMediaConsumptionCheckpoint(stream.Context())
 			cp.ActivityChallenge = &proto.ActivityChallenge{
 				Id:   challenge.ID,
 				Type: challenge.Type,
@@ -57,10 +62,10 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 			}
 		})()
 
-		defer s.rewardsHandler.UnregisterSpectator(stream.Context(), spectator)
+		defer channel.rewardsHandler.UnregisterSpectator(stream.Context(), spectator)
 	}
 
-	statsCleanup, err := s.statsHandler.RegisterSpectator(stream.Context())
+	statsCleanup, err := channel.statsHandler.RegisterSpectator(stream.Context())
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -69,8 +74,10 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	t := time.NewTicker(3 * time.Second)
 	// if we set this ticker to e.g. 10 seconds, it seems to be too long and CloudFlare or something drops connection :(
 
-	onMediaChanged := s.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
-	defer s.mediaQueue.mediaChanged.Unsubscribe(onMediaChanged)
+	onMediaChanged := channel.mediaQueue.mediaChanged.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.mediaQueue.mediaChanged.Unsubscribe(onMediaChanged)
+	onPauseChanged := channel.mediaQueue.playbackPauseChanged.Subscribe(event.AtLeastOnceGuarantee)
+	defer channel.mediaQueue.playbackPauseChanged.Unsubscribe(onPauseChanged)
 	lastPowTask := time.Time{}
 	for {
 		var powTask *WorkRequest
@@ -83,6 +90,8 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 			break
 		case <-onMediaChanged:
 			break
+		case <-onPauseChanged:
+			break
 		case <-stream.Context().Done():
 			return nil
 		case err := <-errChan:
@@ -92,7 +101,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 			lastPowTask = time.Now()
 			break
 		}
-		cp := s.produceMediaConsumptionCheckpoint(stream.Context())
+		cp := channel.produceMediaConsumptionCheckpoint(stream.Context())
 		if powTask != nil {
 			cp.PowTask = &proto.ProofOfWorkTask{
 				Previous: powTask.Data,
@@ -106,9 +115,9 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	}
 }
 
-func (s *grpcServer) produceMediaConsumptionCheckpoint(ctx context.Context) *proto.MediaConsumptionCheckpoint {
-	cp := s.mediaQueue.ProduceCheckpointForAPI()
-	cp.CurrentlyWatching = uint32(s.statsHandler.CurrentlyWatching(ctx))
+func (c *Channel) produceMediaConsumptionCheckpoint(ctx context.Context) *proto.MediaConsumptionCheckpoint {
+	cp := c.mediaQueue.ProduceCheckpointForAPI()
+	cp.CurrentlyWatching = uint32(c.statsHandler.CurrentlyWatching(ctx))
 	return cp
 }
 
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DirectMediaProvider handles the enqueuing of audio and video files served directly over HTTP(S)
+// by hosts controlled by the operator of the service
+type DirectMediaProvider struct {
+	mediaQueue   *MediaQueue
+	allowedHosts map[string]struct{}
+}
+
+var _ MediaProvider = &DirectMediaProvider{}
+
+// NewDirectMediaProvider returns a new DirectMediaProvider which accepts files served by the specified hosts
+func NewDirectMediaProvider(mediaQueue *MediaQueue, allowedHosts []string) *DirectMediaProvider {
+	p := &DirectMediaProvider{
+		mediaQueue:   mediaQueue,
+		allowedHosts: make(map[string]struct{}),
+	}
+	for _, host := range allowedHosts {
+		p.allowedHosts[strings.ToLower(strings.TrimSpace(host))] = struct{}{}
+	}
+	return p
+}
+
+func (p *DirectMediaProvider) CanHandleRequestType(r *proto.EnqueueMediaRequest) bool {
+	_, ok := r.GetMediaInfo().(*proto.EnqueueMediaRequest_DirectMediaData)
+	return ok
+}
+
+func (p *DirectMediaProvider) NewEnqueueRequest(ctx context.Context, r *proto.EnqueueMediaRequest) (EnqueueRequest, EnqueueRequestCreationResult, error) {
+	data := r.GetDirectMediaData()
+
+	mediaURL, err := url.Parse(strings.TrimSpace(data.Url))
+	if err != nil || (mediaURL.Scheme != "https" && mediaURL.Scheme != "http") || mediaURL.Host == "" {
+		return nil, EnqueueRequestCreationFailedMediumIsInvalid, nil
+	}
+	if _, allowed := p.allowedHosts[strings.ToLower(mediaURL.Host)]; !allowed {
+		return nil, EnqueueRequestCreationFailedMediumHostNotAllowed, nil
+	}
+
+	// the server controls when the entry stops playing, so a declared length which is shorter than the
+	// actual file length only cuts playback short, and a longer one only results in a higher price
+	if data.Duration == nil || !data.Duration.IsValid() || data.Duration.AsDuration() <= 0 {
+		return nil, EnqueueRequestCreationFailedMediumIsInvalid, nil
+	}
+	if data.Duration.AsDuration() > maxEnqueuedMediaLength {
+		return nil, EnqueueRequestCreationFailedMediumIsTooLong, nil
+	}
+
+	urlString := mediaURL.String()
+	for _, entry := range p.mediaQueue.Entries() {
+		if directEntry, ok := entry.(*queueEntryDirectMedia); ok {
+			if directEntry.url == urlString {
+				return nil, EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
+			}
+		}
+	}
+
+	title := strings.TrimSpace(data.Title)
+	if title == "" {
+		title = path.Base(mediaURL.Path)
+	}
+	if titleRunes := []rune(title); len(titleRunes) > 150 {
+		// truncate on rune boundaries, otherwise the title could end up being invalid UTF-8
+		title = string(titleRunes[:150])
+	}
+
+	request := &queueEntryDirectMedia{
+		commonQueueEntry: commonQueueEntry{
+			length:      data.Duration.AsDuration(),
+			unskippable: r.Unskippable,
+			requestedBy: requesterFromContext(ctx),
+			donePlaying: event.New(),
+		},
+		url:       urlString,
+		title:     title,
+		audioOnly: data.AudioOnly,
+	}
+
+	return request, EnqueueRequestCreationSucceeded, nil
+}
+
+type queueEntryDirectMedia struct {
+	commonQueueEntry
+	url       string
+	title     string
+	audioOnly bool
+}
+
+func (e *queueEntryDirectMedia) ProduceMediaQueueEntries(requestedBy User, requestCost Amount, unskippable bool, queueID string, contributions []Contribution, credit EnqueueCredit) []MediaQueueEntry {
+	e.fillMediaQueueEntryFields(requestedBy, requestCost, unskippable, queueID, contributions, credit)
+	return []MediaQueueEntry{e}
+}
+
+func (e *queueEntryDirectMedia) Title() string {
+	return e.title
+}
+
+func (e *queueEntryDirectMedia) MediaID() (string, string) {
+	return queueEntryTypeDirectMedia, e.url
+}
+
+func (e *queueEntryDirectMedia) ThumbnailURL() string {
+	return ""
+}
+
+func (e *queueEntryDirectMedia) MediaInfo() MediaInfo {
+	return e
+}
+
+func (e *queueEntryDirectMedia) serializeMediaInfoForAPI() *proto.QueueDirectMediaData {
+	return &proto.QueueDirectMediaData{
+		Url:       e.url,
+		Title:     e.title,
+		AudioOnly: e.audioOnly,
+	}
+}
+
+func (e *queueEntryDirectMedia) SerializeForAPI() *proto.QueueEntry {
+	entry := &proto.QueueEntry{
+		Id:          e.queueID,
+		Length:      durationpb.New(e.length),
+		Unskippable: e.unskippable,
+		RequestCost: e.requestCost.SerializeForAPI(),
+		MediaInfo: &proto.QueueEntry_DirectMediaData{
+			DirectMediaData: e.serializeMediaInfoForAPI(),
+		},
+		Contributions: e.serializeContributionsForAPI(),
+	}
+	if creditedTo := e.CreditedTo(); !creditedTo.IsUnknown() {
+		entry.RequestedBy = creditedTo.SerializeForAPI()
+	}
+	return entry
+}
+
+type queueEntryDirectMediaJsonRepresentation struct {
+	QueueID       string
+	Type          string
+	URL           string
+	Title         string
+	AudioOnly     bool
+	Duration      time.Duration
+	RequestedBy   string
+	RequestCost   *big.Int
+	Unskippable   bool
+	Contributions []Contribution `json:",omitempty"`
+	CreditedTo    string         `json:",omitempty"`
+	Anonymous     bool           `json:",omitempty"`
+}
+
+func (e *queueEntryDirectMedia) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(queueEntryDirectMediaJsonRepresentation{
+		QueueID:       e.queueID,
+		Type:          queueEntryTypeDirectMedia,
+		URL:           e.url,
+		Title:         e.title,
+		AudioOnly:     e.audioOnly,
+		Duration:      e.length,
+		RequestedBy:   e.requestedBy.Address(),
+		RequestCost:   e.requestCost.Int,
+		Unskippable:   e.unskippable,
+		Contributions: e.contributions,
+		CreditedTo:    e.credit.creditedToAddress(),
+		Anonymous:     e.credit.Anonymous,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.url)
+	}
+	return j, nil
+}
+
+func (e *queueEntryDirectMedia) UnmarshalJSON(b []byte) error {
+	var t queueEntryDirectMediaJsonRepresentation
+	if err := json.Unmarshal(b, &t); err != nil {
+		return stacktrace.Propagate(err, "error deserializing queue entry")
+	}
+
+	e.queueID = t.QueueID
+	e.url = t.URL
+	e.title = t.Title
+	e.audioOnly = t.AudioOnly
+	e.length = t.Duration
+	e.requestedBy = userFromPersistedAddress(t.RequestedBy)
+	e.requestCost = Amount{t.RequestCost}
+	e.unskippable = t.Unskippable
+	e.contributions = t.Contributions
+	e.credit = enqueueCreditFromPersisted(t.CreditedTo, t.Anonymous)
+	e.donePlaying = event.New()
+	return nil
+}
+
+func (e *queueEntryDirectMedia) FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket) {
+	ticket.MediaInfo = &proto.EnqueueMediaTicket_DirectMediaData{
+		DirectMediaData: e.serializeMediaInfoForAPI(),
+	}
+}
+
+func (e *queueEntryDirectMedia) ProduceCheckpointForAPI() *proto.MediaConsumptionCheckpoint {
+	cp := &proto.MediaConsumptionCheckpoint{
+		MediaPresent:    true,
+		CurrentPosition: durationpb.New(e.PlayedFor()),
+		RequestCost:     e.requestCost.SerializeForAPI(),
+		// Reward is optionally filled outside this function
+		MediaInfo: &proto.MediaConsumptionCheckpoint_DirectMediaData{
+			DirectMediaData: &proto.NowPlayingDirectMediaData{
+				Url:       e.url,
+				AudioOnly: e.audioOnly,
+			},
+		},
+	}
+	if creditedTo := e.CreditedTo(); !creditedTo.IsUnknown() {
+		cp.RequestedBy = creditedTo.SerializeForAPI()
+	}
+	return cp
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+)
+
+// MediaProvider handles the validation of enqueue requests for one type of media
+// and the creation of the corresponding MediaInfo/MediaQueueEntry implementation
+type MediaProvider interface {
+	// CanHandleRequestType returns whether this provider is responsible for the media info type of the request
+	CanHandleRequestType(r *proto.EnqueueMediaRequest) bool
+	// NewEnqueueRequest validates the media referenced in the request and returns a new EnqueueRequest for it.
+	// The returned request is nil unless the result is EnqueueRequestCreationSucceeded
+	NewEnqueueRequest(ctx context.Context, r *proto.EnqueueMediaRequest) (EnqueueRequest, EnqueueRequestCreationResult, error)
+}
+
+// EnqueueRequestCreationResult is the outcome of the validation of a request to enqueue media
+type EnqueueRequestCreationResult int
+
+const (
+	EnqueueRequestCreationSucceeded EnqueueRequestCreationResult = iota
+	EnqueueRequestCreationFailed
+	EnqueueRequestCreationFailedMediumNotFound
+	EnqueueRequestCreationFailedMediumAgeRestricted
+	EnqueueRequestCreationFailedMediumIsLiveBroadcast
+	EnqueueRequestCreationFailedMediumIsNotEmbeddable
+	EnqueueRequestCreationFailedMediumIsTooLong
+	EnqueueRequestCreationFailedMediumIsAlreadyInQueue
+	EnqueueRequestCreationFailedMediumIsInvalid
+	EnqueueRequestCreationFailedMediumSegmentIsInvalid
+	EnqueueRequestCreationFailedMediumHostNotAllowed
+	EnqueueRequestCreationFailedMediumTypeNotSupported
+	EnqueueRequestCreationFailedEnqueuingDisabled
+	EnqueueRequestCreationFailedEnqueuingStaffOnly
+	EnqueueRequestCreationFailedRequesterQuotaExceeded
+	EnqueueRequestCreationFailedMediumPlayedTooRecently
+	EnqueueRequestCreationFailedMediumHasNoPlayableItems
+)
+
+// maxEnqueuedMediaLength is the maximum length of media that can be enqueued by users
+const maxEnqueuedMediaLength = 30 * time.Minute
+
+// NewEnqueueRequest performs the checks that are common to all types of media and then hands off the request
+// to the MediaProvider responsible for the type of media being enqueued
+func (s *grpcServer) NewEnqueueRequest(ctx context.Context, channel *Channel, r *proto.EnqueueMediaRequest) (EnqueueRequest, EnqueueRequestCreationResult, error) {
+	isAdmin := false
+	user := UserClaimsFromContext(ctx)
+	if banned, err := s.moderationStore.LoadRemoteAddressBannedFromVideoEnqueuing(ctx, RemoteAddressFromContext(ctx)); err == nil && banned {
+		return nil, EnqueueRequestCreationFailedEnqueuingStaffOnly, nil
+	}
+	if user != nil {
+		isAdmin = permissionLevelOrder[user.PermLevel] >= permissionLevelOrder[AdminPermissionLevel]
+		if banned, err := s.moderationStore.LoadPaymentAddressBannedFromVideoEnqueuing(ctx, user.Address()); err == nil && banned {
+			return nil, EnqueueRequestCreationFailedEnqueuingStaffOnly, nil
+		}
+	}
+	if channel.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_DISABLED {
+		return nil, EnqueueRequestCreationFailedEnqueuingDisabled, nil
+	}
+	if !isAdmin && channel.allowVideoEnqueuing == proto.AllowedVideoEnqueuingType_STAFF_ONLY {
+		return nil, EnqueueRequestCreationFailedEnqueuingStaffOnly, nil
+	}
+
+	for _, provider := range channel.mediaProviders {
+		if provider.CanHandleRequestType(r) {
+			request, result, err := provider.NewEnqueueRequest(ctx, r)
+			if err != nil {
+				return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+			}
+			if result != EnqueueRequestCreationSucceeded || isAdmin {
+				return request, result, nil
+			}
+			if collection, ok := request.MediaInfo().(MediaCollection); ok {
+				// skip the items that were played recently, instead of rejecting the whole collection
+				err = collection.SkipItems(func(item MediaInfo) (string, error) {
+					playedRecently, err := s.mediaPlayedRecently(ctx, channel, item)
+					if err != nil || !playedRecently {
+						return "", stacktrace.Propagate(err, "")
+					}
+					return enqueueRequestCreationFailureReason(EnqueueRequestCreationFailedMediumPlayedTooRecently), nil
+				})
+				if err != nil {
+					return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+				}
+				if len(collection.Items()) == 0 {
+					return nil, EnqueueRequestCreationFailedMediumHasNoPlayableItems, nil
+				}
+			} else {
+				playedRecently, err := s.mediaPlayedRecently(ctx, channel, request.MediaInfo())
+				if err != nil {
+					return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+				}
+				if playedRecently {
+					return nil, EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
+				}
+			}
+			if !s.requesterWithinQuota(channel, request) {
+				return nil, EnqueueRequestCreationFailedRequesterQuotaExceeded, nil
+			}
+			return request, result, nil
+		}
+	}
+	return nil, EnqueueRequestCreationFailedMediumTypeNotSupported, nil
+}
+
+// requesterWithinQuota returns whether the requester can add the requested entries to the queue, considering both the
+// entries already in the queue and the tickets pending payment.
+// Requests by users who are not signed in can't be attributed before they are paid for, and aren't limited
+func (s *grpcServer) requesterWithinQuota(channel *Channel, request EnqueueRequest) bool {
+	requester := request.RequestedBy()
+	if requester == nil || requester.IsUnknown() {
+		return true
+	}
+	entries, length := channel.mediaQueue.RequesterUsage(requester)
+	pendingEntries, pendingLength := channel.enqueueManager.PendingTicketsRequestedBy(requester)
+	return channel.mediaQueue.RequesterQuota().Allows(entries+pendingEntries, length+pendingLength,
+		mediaItemCount(request.MediaInfo()), request.MediaInfo().Length())
+}
+
+// mediaPlayedRecently returns whether the media finished playing within the repeat prevention window
+func (s *grpcServer) mediaPlayedRecently(ctx context.Context, channel *Channel, mediaInfo MediaInfo) (bool, error) {
+	if s.mediaRepeatWindow <= 0 {
+		return false, nil
+	}
+	mediaType, mediaID := mediaInfo.MediaID()
+	lastPlayed, err := channel.playedMediaHistory.LoadMediaLastPlayedAt(ctx, mediaType, mediaID)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return !lastPlayed.IsZero() && time.Since(lastPlayed) < s.mediaRepeatWindow, nil
+}
+
+// requesterFromContext returns the user who should be credited with requesting media in the given context
+func requesterFromContext(ctx context.Context) User {
+	userClaims := UserClaimsFromContext(ctx)
+	if userClaims != nil {
+		return userClaims
+	}
+	return &unknownUser{}
+}
@@ -2,12 +2,9 @@ package server
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"io/ioutil"
 	"log"
-	"os"
 	"sync"
+	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
@@ -22,9 +19,23 @@ type MediaQueue struct {
 	queue       []MediaQueueEntry
 	queueMutex  sync.RWMutex
 
-	queueUpdated *event.Event
-	mediaChanged *event.Event
-	entryAdded   *event.Event
+	// pinnedPositions maps the queue ID of pinned entries to the position they are pinned at
+	pinnedPositions map[string]int
+
+	// schedule contains the slots that haven't started yet, ordered by start time
+	schedule []*ScheduledSlot
+
+	requesterQuota   RequesterQuota
+	fairInterleaving bool
+
+	// paused is set when playback is paused for the whole broadcast. Entries that start playing while the queue
+	// is paused are paused immediately
+	paused bool
+
+	queueUpdated         *event.Event
+	mediaChanged         *event.Event
+	entryAdded           *event.Event
+	playbackPauseChanged *event.Event
 
 	// fired when an entry that is not at the top of the queue is removed prematurely
 	// receives the removed entry as an argument
@@ -33,12 +44,14 @@ type MediaQueue struct {
 
 func NewMediaQueue(ctx context.Context, log *log.Logger, statsClient *statsd.Client, persistenceFile string) (*MediaQueue, error) {
 	q := &MediaQueue{
-		log:              log,
-		statsClient:      statsClient,
-		queueUpdated:     event.New(),
-		mediaChanged:     event.New(),
-		entryAdded:       event.New(),
-		deepEntryRemoved: event.New(),
+		log:                  log,
+		statsClient:          statsClient,
+		queueUpdated:         event.New(),
+		mediaChanged:         event.New(),
+		entryAdded:           event.New(),
+		playbackPauseChanged: event.New(),
+		deepEntryRemoved:     event.New(),
+		pinnedPositions:      make(map[string]int),
 	}
 	if persistenceFile != "" {
 		err := q.restoreQueueFromFile(persistenceFile)
@@ -64,48 +77,71 @@ func (q *MediaQueue) Entries() []MediaQueueEntry {
 	return queueCopy
 }
 
-func (q *MediaQueue) Enqueue(entry MediaQueueEntry) {
+// Enqueue adds the entries to the end of the queue, keeping them together and in the order they were passed
+func (q *MediaQueue) Enqueue(entries ...MediaQueueEntry) {
+	if len(entries) == 0 {
+		return
+	}
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	q.queue = append(q.queue, entry)
+	if q.fairInterleaving {
+		q.enqueueFairlyNoMutex(entries...)
+	} else {
+		q.queue = append(q.queue, entries...)
+	}
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify()
-	q.entryAdded.Notify("enqueue", entry)
+	q.entryAdded.Notify("enqueue", entries[0], len(entries))
+}
+
+func (q *MediaQueue) insertNoMutex(position int, entries ...MediaQueueEntry) {
+	q.queue = append(q.queue, entries...)
+	copy(q.queue[position+len(entries):], q.queue[position:])
+	copy(q.queue[position:], entries)
+	q.applyPinnedPositionsNoMutex()
 }
 
-func (q *MediaQueue) playAfterNextNoMutex(entry MediaQueueEntry) {
+func (q *MediaQueue) playAfterNextNoMutex(entries ...MediaQueueEntry) {
 	if len(q.queue) < 2 {
-		q.queue = append(q.queue, entry)
+		q.queue = append(q.queue, entries...)
+		q.applyPinnedPositionsNoMutex()
 	} else {
-		q.queue = append(q.queue, nil)
-		copy(q.queue[2:], q.queue[1:])
-		q.queue[1] = entry
+		q.insertNoMutex(1, entries...)
 	}
 }
 
-func (q *MediaQueue) PlayAfterNext(entry MediaQueueEntry) {
+// PlayAfterNext adds the entries right after the currently playing entry, keeping them together and in the order
+// they were passed
+func (q *MediaQueue) PlayAfterNext(entries ...MediaQueueEntry) {
+	if len(entries) == 0 {
+		return
+	}
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	q.playAfterNextNoMutex(entry)
+	q.playAfterNextNoMutex(entries...)
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify()
-	q.entryAdded.Notify("play_after_next", entry)
+	q.entryAdded.Notify("play_after_next", entries[0], len(entries))
 }
 
-func (q *MediaQueue) PlayNow(entry MediaQueueEntry) {
+// PlayNow adds the entries right after the currently playing entry and skips it, unless it is unskippable
+func (q *MediaQueue) PlayNow(entries ...MediaQueueEntry) {
+	if len(entries) == 0 {
+		return
+	}
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	q.playAfterNextNoMutex(entry)
+	q.playAfterNextNoMutex(entries...)
 	if len(q.queue) > 1 && !q.queue[0].Unskippable() {
 		q.queue[0].Stop()
 	}
 
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify()
-	q.entryAdded.Notify("play_now", entry)
+	q.entryAdded.Notify("play_now", entries[0], len(entries))
 }
 
 func (q *MediaQueue) RemoveEntry(entryID string) (MediaQueueEntry, error) {
@@ -124,6 +160,7 @@ func (q *MediaQueue) RemoveEntry(entryID string) (MediaQueueEntry, error) {
 	for i, entry := range q.queue {
 		if entryID == entry.QueueID() {
 			q.queue = append(q.queue[:i], q.queue[i+1:]...)
+			q.entriesRemovedNoMutex(i, 1)
 			q.deepEntryRemoved.Notify(entry)
 			go q.statsClient.Gauge("queue_length", len(q.queue))
 			q.queueUpdated.Notify()
@@ -161,7 +198,7 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 				continue
 			}
 			if !currentQueueEntry.Playing() {
-				currentQueueEntry.Play()
+				q.startPlaying(currentQueueEntry)
 			}
 			ev := currentQueueEntry.DonePlaying()
 			onNextMedia = ev.Subscribe(event.AtLeastOnceGuarantee)
@@ -173,19 +210,41 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 			q.log.Println("No current queue entry")
 		}
 
+		scheduleTimer := time.NewTimer(0)
+		if !scheduleTimer.Stop() {
+			<-scheduleTimer.C
+		}
+		if startsAt, ok := q.nextScheduledStart(); ok {
+			scheduleTimer.Reset(time.Until(startsAt))
+		}
+
 		select {
 		case <-ctx.Done():
+			scheduleTimer.Stop()
 			unsubscribe()
 			return
 		case <-onNextMedia:
 			q.playNext()
+		case <-scheduleTimer.C:
+			q.startDueScheduledSlots()
 		case <-onQueueUpdated:
 			// loop again to update currentQueueEntry and nextMediaChan
 		}
+		scheduleTimer.Stop()
 		unsubscribe()
 	}
 }
 
+func (q *MediaQueue) startPlaying(entry MediaQueueEntry) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	entry.Play()
+	if q.paused {
+		entry.Pause()
+	}
+}
+
 func (q *MediaQueue) playNext() {
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
@@ -194,6 +253,7 @@ func (q *MediaQueue) playNext() {
 		return
 	}
 	q.queue = q.queue[1:]
+	q.entriesRemovedNoMutex(0, 1)
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify()
 }
@@ -213,54 +273,36 @@ func (q *MediaQueue) ProduceCheckpointForAPI() *proto.MediaConsumptionCheckpoint
 	if len(q.queue) == 0 {
 		return &proto.MediaConsumptionCheckpoint{}
 	}
-	return q.queue[0].ProduceCheckpointForAPI()
-}
-
-func (q *MediaQueue) persistenceWorker(ctx context.Context, file string) {
-	c := q.queueUpdated.Subscribe(event.AtLeastOnceGuarantee)
-	defer q.queueUpdated.Unsubscribe(c)
-
-	for {
-		select {
-		case <-c:
-			marshalled, err := json.Marshal(q.Entries())
-			if err != nil {
-				q.log.Printf("error serializing queue: %v", err)
-				continue
-			}
-			err = ioutil.WriteFile(file, marshalled, 0644)
-			if err != nil {
-				q.log.Printf("error writing queue to file: %v", err)
-				continue
-			}
-		case <-ctx.Done():
-			return
-		}
-	}
+	cp := q.queue[0].ProduceCheckpointForAPI()
+	cp.Paused = q.queue[0].Paused()
+	return cp
 }
 
-func (q *MediaQueue) restoreQueueFromFile(file string) error {
-	b, err := ioutil.ReadFile(file)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil
-		}
-		return stacktrace.Propagate(err, "error reading queue from file: %v", err)
-	}
-
-	var entries []*queueEntryYouTubeVideo
-	err = json.Unmarshal(b, &entries)
-	if err != nil {
-		return stacktrace.Propagate(err, "error decoding queue from file: %v", err)
-	}
+// SetPaused pauses or resumes playback of the whole broadcast.
+// Returns false if playback was already in the requested state
+func (q *MediaQueue) SetPaused(paused bool) bool {
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	q.queue = make([]MediaQueueEntry, len(entries))
-	for i := range entries {
-		q.queue[i] = entries[i]
+	if q.paused == paused {
+		return false
+	}
+	q.paused = paused
+	if len(q.queue) > 0 {
+		if paused {
+			q.queue[0].Pause()
+		} else {
+			q.queue[0].Resume()
+		}
 	}
-	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify()
-	return nil
+	q.playbackPauseChanged.Notify(paused)
+	return true
+}
+
+// Paused returns whether playback of the whole broadcast is paused
+func (q *MediaQueue) Paused() bool {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return q.paused
 }
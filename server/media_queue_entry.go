@@ -2,237 +2,342 @@ package server
 
 import (
 	"encoding/json"
-	"math/big"
+	"sync"
 	"time"
 
-	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/utils/event"
-	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // MediaQueueEntry represents one entry in the media queue
 type MediaQueueEntry interface {
 	json.Marshaler
 	json.Unmarshaler
+	// RequestedBy returns who paid for the entry, or on whose behalf it was paid for
 	RequestedBy() User
+	// CreditedTo returns who is publicly shown as having enqueued the entry, which is an unknown user for anonymous
+	// entries
+	CreditedTo() User
 	RequestCost() Amount
 	Unskippable() bool
+	// Contributions returns who paid for crowdfunded entries and how much each one paid, or nil for other entries
+	Contributions() []Contribution
 	MediaInfo() MediaInfo
 	SerializeForAPI() *proto.QueueEntry
 	ProduceCheckpointForAPI() *proto.MediaConsumptionCheckpoint
 	Play()
+	RestorePlayback(playedFor time.Duration, startedPlayingAt time.Time)
 	Stop()
+	Pause()
+	Resume()
+	Paused() bool
 	Played() bool
 	Playing() bool
 	PlayedFor() time.Duration
+	// StartedPlayingAt returns when playback of the entry first started, possibly before the server was restarted
+	StartedPlayingAt() time.Time
+	// PlaybackTimeSince returns for how long the entry was actually played (i.e. excluding the time it was paused)
+	// since the specified time. Only playback that took place since this server instance started is considered
+	PlaybackTimeSince(time.Time) time.Duration
 	DonePlaying() *event.Event
 
 	QueueID() string
 }
 
 type MediaInfo interface {
+	// MarshalJSON serializes the media so that tickets pending payment can be persisted
+	json.Marshaler
 	Title() string
 	ThumbnailURL() string
 	Length() time.Duration
-	ProduceMediaQueueEntry(requestedBy User, requestCost Amount, unskippable bool, queueID string) MediaQueueEntry
+	// MediaID returns the type of the media and an identifier that is unique within that type
+	MediaID() (string, string)
+	// ProduceMediaQueueEntries returns the queue entries that should be added to the queue, in order, once this media
+	// is paid for. The request cost is split among the returned entries. contributions is only set for crowdfunded
+	// media
+	ProduceMediaQueueEntries(requestedBy User, requestCost Amount, unskippable bool, queueID string, contributions []Contribution, credit EnqueueCredit) []MediaQueueEntry
 	FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket)
 }
 
-type queueEntryYouTubeVideo struct {
-	queueID      string
-	id           string
-	title        string
-	channelTitle string
-	thumbnailURL string
-	duration     time.Duration
-	unskippable  bool
+// MediaCollection is implemented by MediaInfo that consists of multiple items which become separate queue entries,
+// such as playlists
+type MediaCollection interface {
+	MediaInfo
+	Items() []MediaInfo
+	// SkipItems removes the items for which the function returns a non-empty reason, reporting them as skipped
+	SkipItems(reasonToSkip func(MediaInfo) (string, error)) error
+}
+
+// mediaItemCount returns how many queue entries the media will result in
+func mediaItemCount(mediaInfo MediaInfo) int {
+	if collection, ok := mediaInfo.(MediaCollection); ok {
+		return len(collection.Items())
+	}
+	return 1
+}
+
+// commonQueueEntry contains the request information and playback state that is common to all types of
+// MediaQueueEntry. It is meant to be embedded in the media-specific implementations
+type commonQueueEntry struct {
+	queueID     string
+	length      time.Duration
+	unskippable bool
 
 	requestedBy    User
 	requestCost    Amount
+	contributions  []Contribution
+	credit         EnqueueCredit
+	playedBefore   time.Duration
 	startedPlaying time.Time
+	// firstPlayedAt is when playback first started, unlike startedPlaying which doesn't account for pauses and
+	// playStartedAt which is reset when the server restarts
+	firstPlayedAt  time.Time
 	stoppedPlaying time.Time
 	played         bool
 	donePlaying    *event.Event
+
+	playbackMutex sync.Mutex
+	// playStartedAt is when playback started in this server instance, unlike startedPlaying which is adjusted to
+	// account for playedBefore
+	playStartedAt time.Time
+	timer         *time.Timer
+	paused        bool
+	pausedAt      time.Time
+	pausedFor     time.Duration
+	pauses        []playbackPause
+}
+
+type playbackPause struct {
+	start time.Time
+	end   time.Time
 }
 
-func (e *queueEntryYouTubeVideo) ProduceMediaQueueEntry(requestedBy User, requestCost Amount, unskippable bool, queueID string) MediaQueueEntry {
+func (e *commonQueueEntry) fillMediaQueueEntryFields(requestedBy User, requestCost Amount, unskippable bool, queueID string, contributions []Contribution, credit EnqueueCredit) {
 	e.requestedBy = requestedBy
 	e.requestCost = requestCost
 	e.unskippable = unskippable
 	e.queueID = queueID
-	return e
+	e.contributions = contributions
+	e.credit = credit
 }
 
-func (e *queueEntryYouTubeVideo) QueueID() string {
+func (e *commonQueueEntry) QueueID() string {
 	return e.queueID
 }
 
-func (e *queueEntryYouTubeVideo) Title() string {
-	return e.title
-}
-
-func (e *queueEntryYouTubeVideo) ThumbnailURL() string {
-	return e.thumbnailURL
-}
-
-func (e *queueEntryYouTubeVideo) Length() time.Duration {
-	return e.duration
+func (e *commonQueueEntry) Length() time.Duration {
+	return e.length
 }
 
-func (e *queueEntryYouTubeVideo) MediaInfo() MediaInfo {
-	return e
+func (e *commonQueueEntry) RequestedBy() User {
+	return e.requestedBy
 }
 
-func (e *queueEntryYouTubeVideo) RequestedBy() User {
-	return e.requestedBy
+func (e *commonQueueEntry) CreditedTo() User {
+	return e.credit.resolve(e.requestedBy)
 }
 
-func (e *queueEntryYouTubeVideo) RequestCost() Amount {
+func (e *commonQueueEntry) RequestCost() Amount {
 	return e.requestCost
 }
 
-func (e *queueEntryYouTubeVideo) Unskippable() bool {
+func (e *commonQueueEntry) Unskippable() bool {
 	return e.unskippable
 }
 
-func (e *queueEntryYouTubeVideo) SerializeForAPI() *proto.QueueEntry {
-	entry := &proto.QueueEntry{
-		Id:          e.queueID,
-		Length:      durationpb.New(e.duration),
-		Unskippable: e.unskippable,
-		RequestCost: e.requestCost.SerializeForAPI(),
-		MediaInfo: &proto.QueueEntry_YoutubeVideoData{
-			YoutubeVideoData: &proto.QueueYouTubeVideoData{
-				Id:           e.id,
-				Title:        e.title,
-				ThumbnailUrl: e.thumbnailURL,
-				ChannelTitle: e.channelTitle,
-			},
-		},
-	}
-	if !e.requestedBy.IsUnknown() {
-		entry.RequestedBy = e.requestedBy.SerializeForAPI()
-	}
-	return entry
-}
-
-type queueEntryYouTubeVideoJsonRepresentation struct {
-	QueueID      string
-	Type         string
-	ID           string
-	Title        string
-	ChannelTitle string
-	ThumbnailURL string
-	Duration     time.Duration
-	RequestedBy  string
-	RequestCost  *big.Int
-	Unskippable  bool
-}
-
-func (e *queueEntryYouTubeVideo) MarshalJSON() ([]byte, error) {
-	j, err := json.Marshal(queueEntryYouTubeVideoJsonRepresentation{
-		QueueID:      e.queueID,
-		Type:         "youtube-video",
-		ID:           e.id,
-		Title:        e.title,
-		ChannelTitle: e.channelTitle,
-		ThumbnailURL: e.thumbnailURL,
-		Duration:     e.duration,
-		RequestedBy:  e.requestedBy.Address(),
-		RequestCost:  e.requestCost.Int,
-		Unskippable:  e.unskippable,
-	})
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.id)
-	}
-	return j, nil
+func (e *commonQueueEntry) Contributions() []Contribution {
+	return e.contributions
 }
 
-func (e *queueEntryYouTubeVideo) UnmarshalJSON(b []byte) error {
-	var t queueEntryYouTubeVideoJsonRepresentation
-	if err := json.Unmarshal(b, &t); err != nil {
-		return stacktrace.Propagate(err, "error deserializing queue entry")
+// serializeContributionsForAPI returns each contributor's share of the request cost of this entry.
+// Contributors are not identified for anonymous entries
+func (e *commonQueueEntry) serializeContributionsForAPI() []*proto.QueueEntryContribution {
+	if len(e.contributions) == 0 {
+		return nil
 	}
-
-	e.queueID = t.QueueID
-	e.id = t.ID
-	e.title = t.Title
-	e.channelTitle = t.ChannelTitle
-	e.thumbnailURL = t.ThumbnailURL
-	e.duration = t.Duration
-	e.requestedBy = NewAddressOnlyUser(t.RequestedBy)
-	e.requestCost = Amount{t.RequestCost}
-	e.unskippable = t.Unskippable
-	e.donePlaying = event.New()
-	return nil
-}
-
-func (e *queueEntryYouTubeVideo) FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket) {
-	ticket.MediaInfo = &proto.EnqueueMediaTicket_YoutubeVideoData{
-		YoutubeVideoData: &proto.QueueYouTubeVideoData{
-			Id:           e.id,
-			Title:        e.title,
-			ChannelTitle: e.channelTitle,
-			ThumbnailUrl: e.thumbnailURL,
-		},
+	shares := splitAmongContributors(e.requestCost.Int, e.contributions)
+	serialized := make([]*proto.QueueEntryContribution, len(shares))
+	for i, share := range shares {
+		serialized[i] = &proto.QueueEntryContribution{
+			Amount: Amount{share.Amount}.SerializeForAPI(),
+		}
+		if !e.credit.Anonymous {
+			serialized[i].Contributor = NewAddressOnlyUser(share.Address).SerializeForAPI()
+		}
 	}
+	return serialized
 }
 
-func (e *queueEntryYouTubeVideo) ProduceCheckpointForAPI() *proto.MediaConsumptionCheckpoint {
-	cp := &proto.MediaConsumptionCheckpoint{
-		MediaPresent:    true,
-		CurrentPosition: durationpb.New(e.PlayedFor()),
-		RequestCost:     e.requestCost.SerializeForAPI(),
-		// Reward is optionally filled outside this function
-		MediaInfo: &proto.MediaConsumptionCheckpoint_YoutubeVideoData{
-			YoutubeVideoData: &proto.NowPlayingYouTubeVideoData{
-				Id: e.id,
-			},
-		},
-	}
-	if !e.requestedBy.IsUnknown() {
-		cp.RequestedBy = e.requestedBy.SerializeForAPI()
+func (e *commonQueueEntry) Play() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+
+	e.playStartedAt = time.Now()
+	e.startedPlaying = e.playStartedAt.Add(-e.playedBefore)
+	if e.firstPlayedAt.IsZero() {
+		// the entry is being played for the first time, or we don't know when it first started playing
+		e.firstPlayedAt = e.startedPlaying
 	}
-	return cp
+	e.startTimerInMutex(e.length - e.playedBefore)
 }
 
-func (e *queueEntryYouTubeVideo) Play() {
-	e.startedPlaying = time.Now()
-	c := time.NewTimer(e.duration).C
-	go func() {
-		<-c
-		if e.Playing() {
-			e.played = true
-			e.donePlaying.Notify()
+func (e *commonQueueEntry) startTimerInMutex(d time.Duration) {
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		e.playbackMutex.Lock()
+		defer e.playbackMutex.Unlock()
+		// the timer may have been replaced after a pause, while this function was waiting for the lock
+		if e.timer != t || !e.playingInMutex() || e.paused {
+			return
 		}
-	}()
+		e.stoppedPlaying = time.Now()
+		e.played = true
+		e.donePlaying.Notify()
+	})
+	e.timer = t
+}
+
+// RestorePlayback makes the next call to Play resume playback as if the entry had already played for the
+// specified duration (e.g. before the server was restarted). startedPlayingAt may be zero if it is not known
+func (e *commonQueueEntry) RestorePlayback(playedFor time.Duration, startedPlayingAt time.Time) {
+	e.playedBefore = playedFor
+	e.firstPlayedAt = startedPlayingAt
 }
 
-func (e *queueEntryYouTubeVideo) Played() bool {
+func (e *commonQueueEntry) Played() bool {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
 	return e.played
 }
 
-func (e *queueEntryYouTubeVideo) Stop() {
-	if !e.Playing() {
+func (e *commonQueueEntry) Stop() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+
+	if !e.playingInMutex() {
 		return
 	}
+	now := time.Now()
+	if e.paused {
+		e.endPauseInMutex(now)
+	}
+	if e.timer != nil {
+		e.timer.Stop()
+	}
 	e.played = true
-	e.stoppedPlaying = time.Now()
+	e.stoppedPlaying = now
 	e.donePlaying.Notify()
 }
 
-func (e *queueEntryYouTubeVideo) Playing() bool {
+// Pause freezes the playback position of the entry until Resume is called
+func (e *commonQueueEntry) Pause() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+
+	if !e.playingInMutex() || e.paused {
+		return
+	}
+	e.paused = true
+	e.pausedAt = time.Now()
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+}
+
+// Resume continues playback from the position where the entry was paused
+func (e *commonQueueEntry) Resume() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+
+	if !e.playingInMutex() || !e.paused {
+		return
+	}
+	now := time.Now()
+	e.endPauseInMutex(now)
+	e.startTimerInMutex(e.length - e.playedForInMutex(now))
+}
+
+func (e *commonQueueEntry) endPauseInMutex(now time.Time) {
+	e.paused = false
+	e.pausedFor += now.Sub(e.pausedAt)
+	e.pauses = append(e.pauses, playbackPause{start: e.pausedAt, end: now})
+}
+
+func (e *commonQueueEntry) Paused() bool {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	return e.paused
+}
+
+func (e *commonQueueEntry) Playing() bool {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	return e.playingInMutex()
+}
+
+func (e *commonQueueEntry) playingInMutex() bool {
 	return !e.startedPlaying.IsZero() && !e.played
 }
 
-func (e *queueEntryYouTubeVideo) PlayedFor() time.Duration {
-	if !e.Playing() {
-		return e.stoppedPlaying.Sub(e.startedPlaying)
+func (e *commonQueueEntry) PlayedFor() time.Duration {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	return e.playedForInMutex(time.Now())
+}
+
+func (e *commonQueueEntry) StartedPlayingAt() time.Time {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	return e.firstPlayedAt
+}
+
+func (e *commonQueueEntry) playedForInMutex(now time.Time) time.Duration {
+	return e.playbackEndInMutex(now).Sub(e.startedPlaying) - e.pausedFor
+}
+
+// playbackEndInMutex returns the time until which playback took place without interruptions
+func (e *commonQueueEntry) playbackEndInMutex(now time.Time) time.Time {
+	switch {
+	case !e.playingInMutex():
+		return e.stoppedPlaying
+	case e.paused:
+		return e.pausedAt
+	default:
+		return now
+	}
+}
+
+func (e *commonQueueEntry) PlaybackTimeSince(t time.Time) time.Duration {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+
+	if e.playStartedAt.IsZero() {
+		return 0
+	}
+	if t.Before(e.playStartedAt) {
+		t = e.playStartedAt
+	}
+	end := e.playbackEndInMutex(time.Now())
+	if !end.After(t) {
+		return 0
+	}
+	d := end.Sub(t)
+	for _, pause := range e.pauses {
+		// subtract the part of each pause that overlaps with the [t, end] interval
+		start, stop := pause.start, pause.end
+		if start.Before(t) {
+			start = t
+		}
+		if stop.After(end) {
+			stop = end
+		}
+		if stop.After(start) {
+			d -= stop.Sub(start)
+		}
 	}
-	return time.Now().Sub(e.startedPlaying)
+	return d
 }
 
-func (e *queueEntryYouTubeVideo) DonePlaying() *event.Event {
+func (e *commonQueueEntry) DonePlaying() *event.Event {
 	return e.donePlaying
 }
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"time"
+)
+
+// RequesterQuota limits how much of the queue can be taken by the entries of a single requester.
+// Zero values mean there is no limit
+type RequesterQuota struct {
+	MaxEntries     int
+	MaxTotalLength time.Duration
+}
+
+// Allows returns whether a requester who already has the specified number and total length of entries in the
+// queue can add the specified number of entries with the specified total length
+func (r RequesterQuota) Allows(entries int, totalLength time.Duration, newEntries int, newLength time.Duration) bool {
+	if r.MaxEntries > 0 && entries+newEntries > r.MaxEntries {
+		return false
+	}
+	if r.MaxTotalLength > 0 && totalLength+newLength > r.MaxTotalLength {
+		return false
+	}
+	return true
+}
+
+// SetRequesterQuota sets the limits on the entries each requester can have in the queue
+func (q *MediaQueue) SetRequesterQuota(quota RequesterQuota) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+	q.requesterQuota = quota
+}
+
+// RequesterQuota returns the limits on the entries each requester can have in the queue
+func (q *MediaQueue) RequesterQuota() RequesterQuota {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return q.requesterQuota
+}
+
+// SetFairInterleaving sets whether entries added with Enqueue are interleaved with those of other requesters,
+// instead of always being added to the end of the queue
+func (q *MediaQueue) SetFairInterleaving(enabled bool) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+	q.fairInterleaving = enabled
+}
+
+// RequesterUsage returns how many entries requested by the specified user are in the queue and for how long they
+// will play. For the currently playing entry, only the remaining time is considered
+func (q *MediaQueue) RequesterUsage(user User) (int, time.Duration) {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+
+	if user == nil || user.IsUnknown() {
+		return 0, 0
+	}
+
+	count := 0
+	length := time.Duration(0)
+	for i, entry := range q.queue {
+		if entry.RequestedBy() == nil || entry.RequestedBy().IsUnknown() ||
+			entry.RequestedBy().Address() != user.Address() {
+			continue
+		}
+		count++
+		length += entry.MediaInfo().Length()
+		if i == 0 && entry.Playing() {
+			length -= entry.PlayedFor()
+		}
+	}
+	return count, length
+}
+
+// enqueueFairlyNoMutex inserts the entries in a round-robin fashion: the n-th entry of a requester is placed after
+// the n-th entries of all other requesters, i.e. after the last entry from a different requester that doesn't
+// already have more entries ahead of it than the requester of the new entry.
+// Multiple entries passed at once (e.g. the videos of a playlist) are kept together, as if they were one entry.
+// Entries by unknown requesters are each considered to belong to a distinct requester
+func (q *MediaQueue) enqueueFairlyNoMutex(entries ...MediaQueueEntry) {
+	q.insertNoMutex(q.fairEnqueuePositionNoMutex(fairnessRequesterKey(entries[0])), entries...)
+}
+
+// fairEnqueuePositionNoMutex returns the position where enqueueFairlyNoMutex places entries by the requester with
+// the given key
+func (q *MediaQueue) fairEnqueuePositionNoMutex(newKey string) int {
+	rounds := make([]int, len(q.queue))
+	counts := make(map[string]int)
+	for i, e := range q.queue {
+		key := fairnessRequesterKey(e)
+		rounds[i] = counts[key]
+		counts[key]++
+	}
+	newRound := counts[newKey]
+
+	position := len(q.queue)
+	if position > 1 {
+		// the currently playing entry counts towards the rounds, but we never insert in front of it
+		position = 1
+		for i := len(q.queue) - 1; i >= 1; i-- {
+			if rounds[i] <= newRound {
+				position = i + 1
+				break
+			}
+		}
+	}
+	return position
+}
+
+func fairnessRequesterKey(e MediaQueueEntry) string {
+	if e.RequestedBy() == nil || e.RequestedBy().IsUnknown() {
+		return "entry:" + e.QueueID()
+	}
+	return e.RequestedBy().Address()
+}
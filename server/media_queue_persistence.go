@@ -0,0 +1,334 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/event"
+)
+
+// queueFileFormatVersion is the version of the format written by persistenceWorker.
+// It must be incremented, and a migration must be added to queueFileMigrations, whenever
+// a change to the format would prevent older files from being correctly decoded
+const queueFileFormatVersion = 2
+
+const queueEntryTypeYouTubeVideo = "youtube-video"
+const queueEntryTypeDirectMedia = "direct-media"
+const mediaInfoTypeYouTubePlaylist = "youtube-playlist"
+
+// queueEntryTypes maps the Type field of each persisted queue entry to a function returning a new,
+// empty instance of the MediaQueueEntry implementation that can decode it
+var queueEntryTypes = map[string]func() MediaQueueEntry{
+	queueEntryTypeYouTubeVideo: func() MediaQueueEntry { return &queueEntryYouTubeVideo{} },
+	queueEntryTypeDirectMedia:  func() MediaQueueEntry { return &queueEntryDirectMedia{} },
+}
+
+// queueFileMigrations maps a format version to the function that converts a file in that version
+// to the immediately following version
+var queueFileMigrations = map[int]func(queueFileContents) (queueFileContents, error){
+	1: migrateQueueFileFromV1,
+}
+
+// queueResumeMaxDowntime is the maximum server downtime that is considered when resuming playback after a restart.
+// After longer outages, playback resumes from where it was, so that the entire queue isn't skipped
+const queueResumeMaxDowntime = 15 * time.Minute
+
+// queuePlaybackPersistenceInterval is how often the queue is persisted while the current entry is playing,
+// so that playback can be resumed close to where it was after a restart
+const queuePlaybackPersistenceInterval = 5 * time.Second
+
+type queueFileContents struct {
+	Version int
+	Entries []json.RawMessage
+	// CurrentEntryPlayedFor is for how long the first entry had been playing at SavedAt
+	CurrentEntryPlayedFor time.Duration `json:",omitempty"`
+	// CurrentEntryStartedAt is when the first entry first started playing
+	CurrentEntryStartedAt time.Time `json:",omitempty"`
+	SavedAt               time.Time `json:",omitempty"`
+	// PinnedEntries maps the queue ID of pinned entries to the position they are pinned at
+	PinnedEntries map[string]int `json:",omitempty"`
+	// Paused is set when playback of the broadcast was paused
+	Paused bool `json:",omitempty"`
+	// Schedule contains the slots that hadn't started yet
+	Schedule []scheduledSlotFileContents `json:",omitempty"`
+}
+
+type scheduledSlotFileContents struct {
+	ID        string
+	StartsAt  time.Time
+	Interrupt bool
+	Entries   []json.RawMessage
+}
+
+func (q *MediaQueue) persistenceWorker(ctx context.Context, file string) {
+	c := q.queueUpdated.Subscribe(event.AtLeastOnceGuarantee)
+	defer q.queueUpdated.Unsubscribe(c)
+
+	t := time.NewTicker(queuePlaybackPersistenceInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c:
+			err := q.persistQueue(file)
+			if err != nil {
+				q.log.Printf("error persisting queue: %v", err)
+			}
+		case <-t.C:
+			if entry, playing := q.CurrentlyPlaying(); !playing || !entry.Playing() || entry.Paused() {
+				continue
+			}
+			err := q.persistQueue(file)
+			if err != nil {
+				q.log.Printf("error persisting queue: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (q *MediaQueue) persistQueue(file string) error {
+	entries := q.Entries()
+	contents := queueFileContents{
+		Version:       queueFileFormatVersion,
+		Entries:       make([]json.RawMessage, len(entries)),
+		SavedAt:       time.Now(),
+		PinnedEntries: q.PinnedEntries(),
+		Paused:        q.Paused(),
+	}
+	if len(entries) > 0 && entries[0].Playing() {
+		contents.CurrentEntryPlayedFor = entries[0].PlayedFor()
+		contents.CurrentEntryStartedAt = entries[0].StartedPlayingAt()
+	}
+	for i, entry := range entries {
+		marshalled, err := entry.MarshalJSON()
+		if err != nil {
+			return stacktrace.Propagate(err, "error serializing queue entry")
+		}
+		contents.Entries[i] = marshalled
+	}
+	for _, slot := range q.ScheduledSlots() {
+		slotContents := scheduledSlotFileContents{
+			ID:        slot.ID,
+			StartsAt:  slot.StartsAt,
+			Interrupt: slot.Interrupt,
+			Entries:   make([]json.RawMessage, len(slot.Entries)),
+		}
+		for i, entry := range slot.Entries {
+			marshalled, err := entry.MarshalJSON()
+			if err != nil {
+				return stacktrace.Propagate(err, "error serializing scheduled entry")
+			}
+			slotContents.Entries[i] = marshalled
+		}
+		contents.Schedule = append(contents.Schedule, slotContents)
+	}
+
+	marshalled, err := json.Marshal(contents)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing queue")
+	}
+
+	// write to a temporary file first so that a crash halfway through writing doesn't leave us with a corrupt queue
+	tmpFile := file + ".tmp"
+	err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error writing queue to file")
+	}
+	return stacktrace.Propagate(os.Rename(tmpFile, file), "error replacing queue file")
+}
+
+func (q *MediaQueue) restoreQueueFromFile(file string) error {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "error reading queue from file: %v", err)
+	}
+
+	contents, err := decodeQueueFile(b)
+	if err != nil {
+		return stacktrace.Propagate(err, "error decoding queue from file %s", file)
+	}
+
+	if contents.Version < queueFileFormatVersion {
+		// keep the file in the old format around, in case something goes wrong with the migration
+		err = ioutil.WriteFile(file+".bak", b, 0644)
+		if err != nil {
+			return stacktrace.Propagate(err, "error backing up queue file before migration")
+		}
+		q.log.Printf("Migrating queue file from version %d to version %d", contents.Version, queueFileFormatVersion)
+	}
+	for contents.Version < queueFileFormatVersion {
+		migration, ok := queueFileMigrations[contents.Version]
+		if !ok {
+			return stacktrace.NewError("no migration for queue file format version %d", contents.Version)
+		}
+		contents, err = migration(contents)
+		if err != nil {
+			return stacktrace.Propagate(err, "error migrating queue file")
+		}
+	}
+	if contents.Version > queueFileFormatVersion {
+		return stacktrace.NewError("queue file format version %d is newer than the supported version %d",
+			contents.Version, queueFileFormatVersion)
+	}
+
+	entries := make([]MediaQueueEntry, len(contents.Entries))
+	for i, rawEntry := range contents.Entries {
+		// refuse to proceed when an entry can't be decoded, rather than dropping it and having the
+		// persistence worker overwrite the file with an incomplete queue
+		entries[i], err = decodeQueueEntry(rawEntry)
+		if err != nil {
+			return stacktrace.Propagate(err, "error decoding entry %d of queue file", i)
+		}
+	}
+
+	schedule := make([]*ScheduledSlot, len(contents.Schedule))
+	for i, slotContents := range contents.Schedule {
+		schedule[i] = &ScheduledSlot{
+			ID:        slotContents.ID,
+			StartsAt:  slotContents.StartsAt,
+			Interrupt: slotContents.Interrupt,
+			Entries:   make([]MediaQueueEntry, len(slotContents.Entries)),
+		}
+		for j, rawEntry := range slotContents.Entries {
+			schedule[i].Entries[j], err = decodeQueueEntry(rawEntry)
+			if err != nil {
+				return stacktrace.Propagate(err, "error decoding entry %d of scheduled slot %s", j, slotContents.ID)
+			}
+		}
+	}
+
+	skipped := 0
+	if len(entries) > 0 && contents.CurrentEntryPlayedFor > 0 {
+		remaining := q.skipEntriesPlayedDuringDowntime(entries, contents)
+		skipped = len(entries) - len(remaining)
+		entries = remaining
+	}
+
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	q.queue = entries
+	q.schedule = schedule
+	q.paused = contents.Paused
+	for id, position := range contents.PinnedEntries {
+		q.pinnedPositions[id] = position
+	}
+	if skipped > 0 {
+		q.entriesRemovedNoMutex(0, skipped)
+	} else {
+		q.applyPinnedPositionsNoMutex()
+	}
+	go q.statsClient.Gauge("queue_length", len(q.queue))
+	q.queueUpdated.Notify()
+	return nil
+}
+
+// skipEntriesPlayedDuringDowntime removes the entries that would have finished playing, had the server not been
+// down, and sets the playback position of the new first entry accordingly
+func (q *MediaQueue) skipEntriesPlayedDuringDowntime(entries []MediaQueueEntry, contents queueFileContents) []MediaQueueEntry {
+	elapsed := contents.CurrentEntryPlayedFor
+	downtime := time.Since(contents.SavedAt)
+	// while the broadcast is paused, the playback position doesn't advance
+	if !contents.Paused && !contents.SavedAt.IsZero() && downtime > 0 && downtime <= queueResumeMaxDowntime {
+		elapsed += downtime
+	}
+
+	startedAt := contents.CurrentEntryStartedAt
+	for len(entries) > 0 && elapsed >= entries[0].MediaInfo().Length() {
+		elapsed -= entries[0].MediaInfo().Length()
+		q.log.Printf("Skipping queue entry \"%s\" as it would have finished playing during server downtime",
+			entries[0].MediaInfo().Title())
+		entries = entries[1:]
+		// the next entry would have started playing during the downtime
+		startedAt = time.Now().Add(-elapsed)
+	}
+	if len(entries) > 0 {
+		q.log.Printf("Resuming queue entry \"%s\" from position %s", entries[0].MediaInfo().Title(), elapsed)
+		entries[0].RestorePlayback(elapsed, startedAt)
+	}
+	return entries
+}
+
+func decodeQueueFile(b []byte) (queueFileContents, error) {
+	var contents queueFileContents
+	if len(bytes.TrimSpace(b)) > 0 && bytes.TrimSpace(b)[0] == '[' {
+		// version 1 files consist of just the array of entries
+		contents.Version = 1
+		err := json.Unmarshal(b, &contents.Entries)
+		return contents, stacktrace.Propagate(err, "")
+	}
+
+	err := json.Unmarshal(b, &contents)
+	if err != nil {
+		return contents, stacktrace.Propagate(err, "")
+	}
+	if contents.Version == 0 {
+		return contents, stacktrace.NewError("queue file is missing format version")
+	}
+	return contents, nil
+}
+
+func decodeQueueEntry(rawEntry json.RawMessage) (MediaQueueEntry, error) {
+	var typeOnly struct {
+		Type string
+	}
+	err := json.Unmarshal(rawEntry, &typeOnly)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding queue entry type")
+	}
+	newEntry, ok := queueEntryTypes[typeOnly.Type]
+	if !ok {
+		return nil, stacktrace.NewError("unknown queue entry type \"%s\"", typeOnly.Type)
+	}
+	entry := newEntry()
+	err = entry.UnmarshalJSON(rawEntry)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding queue entry of type %s", typeOnly.Type)
+	}
+	return entry, nil
+}
+
+// userFromPersistedAddress returns the User for a requester address stored in the queue file.
+// Entries requested by unknown users are stored with an empty address
+func userFromPersistedAddress(address string) User {
+	if address == "" {
+		return &unknownUser{}
+	}
+	return NewAddressOnlyUser(address)
+}
+
+// migrateQueueFileFromV1 wraps the entries in the versioned format.
+// The only entry type that existed in version 1 was YouTube videos
+func migrateQueueFileFromV1(contents queueFileContents) (queueFileContents, error) {
+	migrated := queueFileContents{
+		Version: 2,
+		Entries: make([]json.RawMessage, len(contents.Entries)),
+	}
+	for i, rawEntry := range contents.Entries {
+		// use RawMessage for the values so that big numbers like the request cost are preserved exactly
+		var fields map[string]json.RawMessage
+		err := json.Unmarshal(rawEntry, &fields)
+		if err != nil {
+			return migrated, stacktrace.Propagate(err, "")
+		}
+		if t, ok := fields["Type"]; !ok || string(t) == `""` {
+			fields["Type"], _ = json.Marshal(queueEntryTypeYouTubeVideo)
+		}
+		migrated.Entries[i], err = json.Marshal(fields)
+		if err != nil {
+			return migrated, stacktrace.Propagate(err, "")
+		}
+	}
+	return migrated, nil
+}
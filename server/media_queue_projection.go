@@ -0,0 +1,203 @@
+package server
+
+import (
+	"time"
+)
+
+// StartTimeProjection contains the projected start times of the entries in the queue and in the schedule.
+// Projections assume that entries play to the end unless interrupted by a scheduled slot, and, while playback is
+// paused, that it resumes immediately
+type StartTimeProjection struct {
+	Entries     []MediaQueueEntry
+	EntryStarts []time.Time
+	Slots       []*ScheduledSlot
+	// SlotStarts contains the projected start time of each entry of each slot, in the same order as Slots
+	SlotStarts [][]time.Time
+}
+
+// ProjectStartTimes returns the projected start time of every entry in the queue and in the schedule
+func (q *MediaQueue) ProjectStartTimes() StartTimeProjection {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+
+	projection := StartTimeProjection{
+		Entries:     make([]MediaQueueEntry, len(q.queue)),
+		EntryStarts: make([]time.Time, len(q.queue)),
+		Slots:       make([]*ScheduledSlot, len(q.schedule)),
+		SlotStarts:  make([][]time.Time, len(q.schedule)),
+	}
+	copy(projection.Entries, q.queue)
+	copy(projection.Slots, q.schedule)
+
+	items := make([]projectedItem, len(q.queue))
+	for i, entry := range q.queue {
+		items[i] = projectedItem{entry: entry, start: &projection.EntryStarts[i]}
+	}
+	slots := make([][]projectedItem, len(q.schedule))
+	for i, slot := range q.schedule {
+		projection.SlotStarts[i] = make([]time.Time, len(slot.Entries))
+		for j, entry := range slot.Entries {
+			slots[i] = append(slots[i], projectedItem{entry: entry, start: &projection.SlotStarts[i][j]})
+		}
+	}
+	q.simulatePlaybackNoMutex(time.Now(), items, slots, false)
+	return projection
+}
+
+// ProjectNewEntryStartTimes returns when media requested by the given user would start playing, if it were added
+// to the queue right now using Enqueue, PlayAfterNext and PlayNow, respectively
+func (q *MediaQueue) ProjectNewEntryStartTimes(requestedBy User) (time.Time, time.Time, time.Time) {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+
+	enqueuePosition := len(q.queue)
+	if q.fairInterleaving {
+		// entries by unknown requesters are each considered to belong to a distinct requester
+		key := "entry:"
+		if requestedBy != nil && !requestedBy.IsUnknown() {
+			key = requestedBy.Address()
+		}
+		enqueuePosition = q.fairEnqueuePositionNoMutex(key)
+	}
+	playNextPosition := 1
+	if len(q.queue) == 0 {
+		playNextPosition = 0
+	}
+
+	now := time.Now()
+	return q.projectNewEntryStartNoMutex(now, enqueuePosition, false),
+		q.projectNewEntryStartNoMutex(now, playNextPosition, false),
+		q.projectNewEntryStartNoMutex(now, playNextPosition, true)
+}
+
+func (q *MediaQueue) projectNewEntryStartNoMutex(now time.Time, position int, skipCurrent bool) time.Time {
+	var start time.Time
+	items := make([]projectedItem, 0, len(q.queue)+1)
+	for _, entry := range q.queue {
+		items = append(items, projectedItem{entry: entry})
+	}
+	items = append(items, projectedItem{})
+	copy(items[position+1:], items[position:])
+	items[position] = projectedItem{start: &start}
+
+	slots := make([][]projectedItem, len(q.schedule))
+	for i, slot := range q.schedule {
+		for _, entry := range slot.Entries {
+			slots[i] = append(slots[i], projectedItem{entry: entry})
+		}
+	}
+	q.simulatePlaybackNoMutex(now, items, slots, skipCurrent)
+	return start
+}
+
+// projectedItem is an entry whose start time is being projected. A nil entry represents media that is not in the
+// queue yet, whose length doesn't matter as only its start time is of interest
+type projectedItem struct {
+	entry MediaQueueEntry
+	// start, if not nil, receives the projected start time
+	start *time.Time
+}
+
+func (p projectedItem) length() time.Duration {
+	if p.entry == nil {
+		return 0
+	}
+	return p.entry.MediaInfo().Length()
+}
+
+func (p projectedItem) unskippable() bool {
+	return p.entry != nil && p.entry.Unskippable()
+}
+
+func (p projectedItem) recordStart(t time.Time) {
+	if p.start != nil {
+		*p.start = t
+	}
+}
+
+// simulatePlaybackNoMutex plays the items in the order they would play, starting with the first one, following the
+// same rules startDueScheduledSlots applies when slots start. slots must be ordered by start time, like q.schedule.
+// If skipCurrent is set, the first item is skipped right away unless it is unskippable, like PlayNow does
+func (q *MediaQueue) simulatePlaybackNoMutex(now time.Time, items []projectedItem, slots [][]projectedItem, skipCurrent bool) {
+	var current *projectedItem
+	var currentStart, currentEnd time.Time
+
+	startNext := func(at time.Time) {
+		item := items[0]
+		items = items[1:]
+		current = &item
+		currentStart = at
+		currentEnd = at.Add(item.length())
+		item.recordStart(at)
+	}
+
+	if len(items) > 0 {
+		startNext(now)
+		if head := current.entry; head != nil && head.Playing() {
+			currentStart = now.Add(-head.PlayedFor())
+			currentEnd = now.Add(head.MediaInfo().Length() - head.PlayedFor())
+			current.recordStart(currentStart)
+		}
+		if skipCurrent && !current.unskippable() {
+			currentEnd = now
+		}
+	}
+
+	// takeDueSlots removes the slots that have started by the specified time, returning their items along with
+	// whether any of them interrupts, just like startDueScheduledSlots does when it runs at that time
+	takeDueSlots := func(at time.Time) ([]projectedItem, bool) {
+		due := []projectedItem{}
+		interrupt := false
+		for len(slots) > 0 {
+			slot := q.schedule[len(q.schedule)-len(slots)]
+			if slot.StartsAt.After(at) {
+				break
+			}
+			due = append(due, slots[0]...)
+			interrupt = interrupt || slot.Interrupt
+			slots = slots[1:]
+		}
+		return due, interrupt
+	}
+
+	t := now
+	for {
+		if current == nil {
+			if len(items) == 0 {
+				if len(slots) == 0 {
+					return
+				}
+				// nothing plays until the next slot starts
+				t = latestTime(t, q.schedule[len(q.schedule)-len(slots)].StartsAt)
+				items, _ = takeDueSlots(t)
+			}
+			startNext(t)
+		}
+
+		// slots that start while the current item plays are placed right after it, like playAfterNextNoMutex does
+		for len(slots) > 0 {
+			at := latestTime(q.schedule[len(q.schedule)-len(slots)].StartsAt, currentStart, now)
+			if !at.Before(currentEnd) {
+				break
+			}
+			due, interrupt := takeDueSlots(at)
+			if interrupt && !current.unskippable() {
+				currentEnd = at
+			}
+			items = append(due, items...)
+		}
+
+		t = currentEnd
+		current = nil
+	}
+}
+
+func latestTime(times ...time.Time) time.Time {
+	latest := times[0]
+	for _, t := range times[1:] {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/palantir/stacktrace"
+)
+
+// Rules for moving queue entries:
+//  - the entry at the top of the queue is the one currently playing and can't be moved, nor can other entries
+//    be moved in front of it. Entries whose playback has already started are therefore never affected, regardless
+//    of whether they are unskippable (the unskippable flag only matters while an entry is playing, so it travels
+//    with the entry to its new position)
+//  - moving entries does not change what was paid for them: no additional charges or refunds take place, and the
+//    reward distributed when an entry finishes playing is still based on its original request cost
+//  - pinned entries stay at the position they were pinned at: entries enqueued with "play next" or "play now" and
+//    entries moved by moderators are placed behind them. Pinned entries still advance as entries in front of them
+//    finish playing or are removed, and are unpinned once they reach the top of the queue
+
+// ErrQueueEntryNotMoved is returned when a movement would leave the entry at the same position and with the same pin
+// state, e.g. when moving the entry at the bottom of the queue down
+var ErrQueueEntryNotMoved = errors.New("the entry is already at that position")
+
+// MoveEntryTo moves the entry with the given ID to the specified position in the queue, optionally pinning it at
+// that position. Returns the positions of the entry before and after the move
+func (q *MediaQueue) MoveEntryTo(entryID string, position int, pin bool) (MediaQueueEntry, int, int, error) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	from, err := q.movableEntryIndexNoMutex(entryID)
+	if err != nil {
+		return nil, 0, 0, stacktrace.Propagate(err, "")
+	}
+	return q.moveEntryNoMutex(from, position, pin)
+}
+
+// MoveEntryBy moves the entry with the given ID by the specified number of positions (negative offsets move the
+// entry closer to the top of the queue), optionally pinning it at its new position.
+// Returns the positions of the entry before and after the move
+func (q *MediaQueue) MoveEntryBy(entryID string, offset int, pin bool) (MediaQueueEntry, int, int, error) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	from, err := q.movableEntryIndexNoMutex(entryID)
+	if err != nil {
+		return nil, 0, 0, stacktrace.Propagate(err, "")
+	}
+	return q.moveEntryNoMutex(from, from+offset, pin)
+}
+
+// SwapEntries swaps the positions of the two entries with the given IDs.
+// Pinned entries remain pinned, at their new position. Returns the entries along with the previous and new positions of
+// the first one
+func (q *MediaQueue) SwapEntries(entryID1, entryID2 string) (MediaQueueEntry, MediaQueueEntry, int, int, error) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	if entryID1 == entryID2 {
+		return nil, nil, 0, 0, stacktrace.NewError("can't swap an entry with itself")
+	}
+
+	i, err := q.movableEntryIndexNoMutex(entryID1)
+	if err != nil {
+		return nil, nil, 0, 0, stacktrace.Propagate(err, "")
+	}
+	j, err := q.movableEntryIndexNoMutex(entryID2)
+	if err != nil {
+		return nil, nil, 0, 0, stacktrace.Propagate(err, "")
+	}
+
+	q.queue[i], q.queue[j] = q.queue[j], q.queue[i]
+	if _, pinned := q.pinnedPositions[entryID1]; pinned {
+		q.pinnedPositions[entryID1] = j
+	}
+	if _, pinned := q.pinnedPositions[entryID2]; pinned {
+		q.pinnedPositions[entryID2] = i
+	}
+
+	q.queueUpdated.Notify()
+	return q.queue[j], q.queue[i], i, j, nil
+}
+
+// PinnedEntries returns the queue IDs of the pinned entries, mapped to the position they are pinned at
+func (q *MediaQueue) PinnedEntries() map[string]int {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	pinned := make(map[string]int, len(q.pinnedPositions))
+	for id, position := range q.pinnedPositions {
+		pinned[id] = position
+	}
+	return pinned
+}
+
+func (q *MediaQueue) movableEntryIndexNoMutex(entryID string) (int, error) {
+	for i, entry := range q.queue {
+		if entry.QueueID() == entryID {
+			if i == 0 {
+				return 0, stacktrace.NewError("the currently playing entry can't be moved")
+			}
+			return i, nil
+		}
+	}
+	return 0, stacktrace.NewError("entry not found in the queue")
+}
+
+func (q *MediaQueue) moveEntryNoMutex(from, to int, pin bool) (MediaQueueEntry, int, int, error) {
+	if to < 1 {
+		to = 1
+	}
+	if to > len(q.queue)-1 {
+		to = len(q.queue) - 1
+	}
+
+	entry := q.queue[from]
+	pinnedAt, pinned := q.pinnedPositions[entry.QueueID()]
+	if to == from && pinned == pin && (!pin || pinnedAt == to) {
+		return nil, 0, 0, stacktrace.Propagate(ErrQueueEntryNotMoved, "")
+	}
+	if pin {
+		if q.positionIsPinnedNoMutex(to, entry.QueueID()) {
+			return nil, 0, 0, stacktrace.NewError("another entry is already pinned at position %d", to)
+		}
+		q.pinnedPositions[entry.QueueID()] = to
+	} else {
+		// skip over positions taken by pinned entries, in the direction of the movement
+		direction := 1
+		if to < from {
+			direction = -1
+		}
+		for q.positionIsPinnedNoMutex(to, entry.QueueID()) {
+			to += direction
+			if to < 1 || to > len(q.queue)-1 {
+				return nil, 0, 0, stacktrace.NewError("no unpinned position available to move the entry to")
+			}
+		}
+		delete(q.pinnedPositions, entry.QueueID())
+	}
+
+	q.queue = append(q.queue[:from], q.queue[from+1:]...)
+	q.queue = append(q.queue, nil)
+	copy(q.queue[to+1:], q.queue[to:])
+	q.queue[to] = entry
+	q.applyPinnedPositionsNoMutex()
+
+	// the entry may have been displaced by entries pinned in front of it
+	for i := range q.queue {
+		if q.queue[i] == entry {
+			to = i
+			break
+		}
+	}
+
+	q.queueUpdated.Notify()
+	return entry, from, to, nil
+}
+
+func (q *MediaQueue) positionIsPinnedNoMutex(position int, exceptEntryID string) bool {
+	for id, pinnedPosition := range q.pinnedPositions {
+		if pinnedPosition == position && id != exceptEntryID {
+			return true
+		}
+	}
+	return false
+}
+
+// entriesRemovedNoMutex must be called after an entry is removed from the queue at the specified position,
+// so that pinned entries behind it advance with the rest of the queue
+func (q *MediaQueue) entriesRemovedNoMutex(position, count int) {
+	for id, pinnedPosition := range q.pinnedPositions {
+		if pinnedPosition > position {
+			q.pinnedPositions[id] = pinnedPosition - count
+		}
+	}
+	q.applyPinnedPositionsNoMutex()
+}
+
+// applyPinnedPositionsNoMutex places pinned entries back at the position they are pinned at, after other entries
+// were added or moved. Pins of entries that are no longer in the queue or that reached the top are removed
+func (q *MediaQueue) applyPinnedPositionsNoMutex() {
+	if len(q.pinnedPositions) == 0 {
+		return
+	}
+
+	type pinnedEntry struct {
+		entry    MediaQueueEntry
+		position int
+	}
+	pinned := []pinnedEntry{}
+	unpinned := make([]MediaQueueEntry, 0, len(q.queue))
+	for i, entry := range q.queue {
+		position, isPinned := q.pinnedPositions[entry.QueueID()]
+		if isPinned && i > 0 && position > 0 {
+			pinned = append(pinned, pinnedEntry{entry, position})
+		} else {
+			unpinned = append(unpinned, entry)
+		}
+	}
+
+	q.pinnedPositions = make(map[string]int)
+	if len(pinned) == 0 {
+		return
+	}
+	sort.SliceStable(pinned, func(i, j int) bool {
+		return pinned[i].position < pinned[j].position
+	})
+
+	q.queue = unpinned
+	for _, p := range pinned {
+		position := p.position
+		if position > len(q.queue) {
+			position = len(q.queue)
+		}
+		q.queue = append(q.queue, nil)
+		copy(q.queue[position+1:], q.queue[position:])
+		q.queue[position] = p.entry
+		q.pinnedPositions[p.entry.QueueID()] = p.position
+	}
+}
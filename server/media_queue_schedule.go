@@ -0,0 +1,164 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Rules for scheduled media:
+//  - scheduled entries are kept outside of the queue until their start time, at which point they are placed right
+//    after the currently playing entry, as if they had been enqueued with "play next"
+//  - if the slot interrupts, the currently playing entry is skipped so that the scheduled entries start on time,
+//    unless it is unskippable. Otherwise, they start once the currently playing entry ends
+//  - slots whose start time passed while the server was down start as soon as the queue is processed again
+//  - slots with the same start time are started in the order they were scheduled
+
+// ScheduledSlot is a set of entries that will be added to the queue at a specific time
+type ScheduledSlot struct {
+	ID        string
+	StartsAt  time.Time
+	Interrupt bool
+	Entries   []MediaQueueEntry
+}
+
+// Length returns the combined length of the entries in the slot
+func (s *ScheduledSlot) Length() time.Duration {
+	length := time.Duration(0)
+	for _, entry := range s.Entries {
+		length += entry.MediaInfo().Length()
+	}
+	return length
+}
+
+// SerializeForAPI serializes the slot for the API
+func (s *ScheduledSlot) SerializeForAPI() *proto.ScheduledMedia {
+	scheduled := &proto.ScheduledMedia{
+		Id:        s.ID,
+		StartsAt:  timestamppb.New(s.StartsAt),
+		Interrupt: s.Interrupt,
+		Entries:   make([]*proto.QueueEntry, len(s.Entries)),
+	}
+	for i, entry := range s.Entries {
+		scheduled.Entries[i] = entry.SerializeForAPI()
+	}
+	return scheduled
+}
+
+// Schedule adds a slot with the given entries to the schedule
+func (q *MediaQueue) Schedule(slot *ScheduledSlot) error {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	if len(slot.Entries) == 0 {
+		return stacktrace.NewError("the slot has no entries")
+	}
+	for _, s := range q.schedule {
+		if s.ID == slot.ID {
+			return stacktrace.NewError("a slot with ID %s is already scheduled", slot.ID)
+		}
+	}
+	q.schedule = append(q.schedule, slot)
+	sort.SliceStable(q.schedule, func(i, j int) bool {
+		return q.schedule[i].StartsAt.Before(q.schedule[j].StartsAt)
+	})
+	q.queueUpdated.Notify()
+	return nil
+}
+
+// Unschedule removes the slot with the given ID from the schedule
+func (q *MediaQueue) Unschedule(slotID string) (*ScheduledSlot, error) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	for i, slot := range q.schedule {
+		if slot.ID == slotID {
+			q.schedule = append(q.schedule[:i], q.schedule[i+1:]...)
+			q.queueUpdated.Notify()
+			return slot, nil
+		}
+	}
+	return nil, stacktrace.NewError("slot not found in the schedule")
+}
+
+// ScheduledSlots returns the slots that haven't started yet, ordered by start time
+func (q *MediaQueue) ScheduledSlots() []*ScheduledSlot {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	slots := make([]*ScheduledSlot, len(q.schedule))
+	copy(slots, q.schedule)
+	return slots
+}
+
+// ScheduledEntriesAhead returns how many scheduled entries will start playing before an entry added to the end of
+// the queue right now, considering that each slot that starts before the queue ends pushes the end of the queue back
+func (q *MediaQueue) ScheduledEntriesAhead() int {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+
+	queueEnd := time.Now().Add(q.remainingPlayTimeNoMutex())
+	count := 0
+	for _, slot := range q.schedule {
+		if !slot.StartsAt.Before(queueEnd) {
+			break
+		}
+		count += len(slot.Entries)
+		queueEnd = queueEnd.Add(slot.Length())
+	}
+	return count
+}
+
+// remainingPlayTimeNoMutex returns for how long the entries currently in the queue will play
+func (q *MediaQueue) remainingPlayTimeNoMutex() time.Duration {
+	remaining := time.Duration(0)
+	for i, entry := range q.queue {
+		remaining += entry.MediaInfo().Length()
+		if i == 0 && entry.Playing() {
+			remaining -= entry.PlayedFor()
+		}
+	}
+	return remaining
+}
+
+// nextScheduledStart returns when the earliest slot in the schedule starts, or false if the schedule is empty
+func (q *MediaQueue) nextScheduledStart() (time.Time, bool) {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	if len(q.schedule) == 0 {
+		return time.Time{}, false
+	}
+	return q.schedule[0].StartsAt, true
+}
+
+// startDueScheduledSlots moves the entries of the slots whose start time has been reached into the queue
+func (q *MediaQueue) startDueScheduledSlots() {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	now := time.Now()
+	entries := []MediaQueueEntry{}
+	interrupt := false
+	for len(q.schedule) > 0 && !q.schedule[0].StartsAt.After(now) {
+		slot := q.schedule[0]
+		q.schedule = q.schedule[1:]
+		entries = append(entries, slot.Entries...)
+		interrupt = interrupt || slot.Interrupt
+		q.log.Printf("Starting scheduled slot %s with %d entries", slot.ID, len(slot.Entries))
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	hadCurrentEntry := len(q.queue) > 0
+	q.playAfterNextNoMutex(entries...)
+	if interrupt && hadCurrentEntry && !q.queue[0].Unskippable() {
+		q.queue[0].Stop()
+	}
+
+	go q.statsClient.Gauge("queue_length", len(q.queue))
+	q.queueUpdated.Notify()
+	q.entryAdded.Notify("scheduled", entries[0], len(entries))
+}
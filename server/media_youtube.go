@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/rickb777/date/period"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/api/youtube/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// YouTubeMediaProvider handles the enqueuing of YouTube videos
+type YouTubeMediaProvider struct {
+	mediaQueue *MediaQueue
+	youtube    *youtube.Service
+}
+
+var _ MediaProvider = &YouTubeMediaProvider{}
+
+// NewYouTubeMediaProvider returns a new YouTubeMediaProvider
+func NewYouTubeMediaProvider(mediaQueue *MediaQueue, youtube *youtube.Service) *YouTubeMediaProvider {
+	return &YouTubeMediaProvider{
+		mediaQueue: mediaQueue,
+		youtube:    youtube,
+	}
+}
+
+func (p *YouTubeMediaProvider) CanHandleRequestType(r *proto.EnqueueMediaRequest) bool {
+	_, ok := r.GetMediaInfo().(*proto.EnqueueMediaRequest_YoutubeVideoData)
+	return ok
+}
+
+func (p *YouTubeMediaProvider) NewEnqueueRequest(ctx context.Context, r *proto.EnqueueMediaRequest) (EnqueueRequest, EnqueueRequestCreationResult, error) {
+	videoID := r.GetYoutubeVideoData().GetId()
+
+	if youTubeVideoInQueue(p.mediaQueue, videoID) {
+		return nil, EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
+	}
+
+	response, err := p.youtube.Videos.List([]string{"snippet", "contentDetails", "status"}).Id(videoID).MaxResults(1).Do()
+	if err != nil {
+		return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+
+	if len(response.Items) == 0 {
+		return nil, EnqueueRequestCreationFailedMediumNotFound, nil
+	}
+
+	videoItem := response.Items[0]
+	videoDuration, result, err := checkYouTubeVideo(videoItem)
+	if err != nil || result != EnqueueRequestCreationSucceeded {
+		return nil, result, stacktrace.Propagate(err, "")
+	}
+
+	startOffset, endOffset, ok := youTubeVideoSegment(r.GetYoutubeVideoData(), videoDuration)
+	if !ok {
+		return nil, EnqueueRequestCreationFailedMediumSegmentIsInvalid, nil
+	}
+
+	// the length limit applies to the part of the video that will actually play
+	if endOffset-startOffset > maxEnqueuedMediaLength {
+		return nil, EnqueueRequestCreationFailedMediumIsTooLong, nil
+	}
+
+	request := newYouTubeVideoQueueEntry(videoItem, startOffset, endOffset, r.Unskippable, requesterFromContext(ctx))
+	return request, EnqueueRequestCreationSucceeded, nil
+}
+
+func youTubeVideoInQueue(mediaQueue *MediaQueue, videoID string) bool {
+	for _, entry := range mediaQueue.Entries() {
+		if ytEntry, ok := entry.(*queueEntryYouTubeVideo); ok {
+			if ytEntry.id == videoID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkYouTubeVideo performs the checks that apply to every YouTube video that is enqueued, except for the length
+// limit, and returns the duration of the video
+func checkYouTubeVideo(videoItem *youtube.Video) (time.Duration, EnqueueRequestCreationResult, error) {
+	if videoItem.ContentDetails.ContentRating.YtRating == "ytAgeRestricted" {
+		return 0, EnqueueRequestCreationFailedMediumAgeRestricted, nil
+	}
+
+	if !videoItem.Status.Embeddable {
+		return 0, EnqueueRequestCreationFailedMediumIsNotEmbeddable, nil
+	}
+
+	if videoItem.Snippet.LiveBroadcastContent != "none" {
+		return 0, EnqueueRequestCreationFailedMediumIsLiveBroadcast, nil
+	}
+
+	videoDuration, err := period.Parse(videoItem.ContentDetails.Duration)
+	if err != nil {
+		return 0, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "error parsing video duration")
+	}
+	return videoDuration.DurationApprox(), EnqueueRequestCreationSucceeded, nil
+}
+
+func newYouTubeVideoQueueEntry(videoItem *youtube.Video, startOffset, endOffset time.Duration, unskippable bool, requestedBy User) *queueEntryYouTubeVideo {
+	return &queueEntryYouTubeVideo{
+		commonQueueEntry: commonQueueEntry{
+			length:      endOffset - startOffset,
+			unskippable: unskippable,
+			requestedBy: requestedBy,
+			donePlaying: event.New(),
+		},
+		id:           videoItem.Id,
+		title:        videoItem.Snippet.Title,
+		channelTitle: videoItem.Snippet.ChannelTitle,
+		thumbnailURL: videoItem.Snippet.Thumbnails.Default.Url,
+		startOffset:  startOffset,
+		endOffset:    endOffset,
+	}
+}
+
+// youTubeVideoSegment returns the start and end offsets of the part of the video that should play, as requested.
+// When no offsets are specified, the whole video plays
+func youTubeVideoSegment(data *proto.EnqueueYouTubeVideoData, videoDuration time.Duration) (time.Duration, time.Duration, bool) {
+	startOffset := time.Duration(0)
+	endOffset := videoDuration
+	if data.StartOffset != nil {
+		if !data.StartOffset.IsValid() {
+			return 0, 0, false
+		}
+		startOffset = data.StartOffset.AsDuration()
+	}
+	if data.EndOffset != nil {
+		if !data.EndOffset.IsValid() {
+			return 0, 0, false
+		}
+		endOffset = data.EndOffset.AsDuration()
+	}
+	if startOffset < 0 || endOffset <= startOffset || endOffset > videoDuration {
+		return 0, 0, false
+	}
+	return startOffset, endOffset, true
+}
+
+type queueEntryYouTubeVideo struct {
+	commonQueueEntry
+	id           string
+	title        string
+	channelTitle string
+	thumbnailURL string
+	startOffset  time.Duration
+	endOffset    time.Duration
+}
+
+func (e *queueEntryYouTubeVideo) ProduceMediaQueueEntries(requestedBy User, requestCost Amount, unskippable bool, queueID string, contributions []Contribution, credit EnqueueCredit) []MediaQueueEntry {
+	e.fillMediaQueueEntryFields(requestedBy, requestCost, unskippable, queueID, contributions, credit)
+	return []MediaQueueEntry{e}
+}
+
+func (e *queueEntryYouTubeVideo) Title() string {
+	return e.title
+}
+
+func (e *queueEntryYouTubeVideo) MediaID() (string, string) {
+	return queueEntryTypeYouTubeVideo, e.id
+}
+
+func (e *queueEntryYouTubeVideo) ThumbnailURL() string {
+	return e.thumbnailURL
+}
+
+func (e *queueEntryYouTubeVideo) MediaInfo() MediaInfo {
+	return e
+}
+
+func (e *queueEntryYouTubeVideo) serializeMediaInfoForAPI() *proto.QueueYouTubeVideoData {
+	return &proto.QueueYouTubeVideoData{
+		Id:           e.id,
+		Title:        e.title,
+		ThumbnailUrl: e.thumbnailURL,
+		ChannelTitle: e.channelTitle,
+		StartOffset:  durationpb.New(e.startOffset),
+		EndOffset:    durationpb.New(e.endOffset),
+	}
+}
+
+func (e *queueEntryYouTubeVideo) SerializeForAPI() *proto.QueueEntry {
+	entry := &proto.QueueEntry{
+		Id:          e.queueID,
+		Length:      durationpb.New(e.length),
+		Unskippable: e.unskippable,
+		RequestCost: e.requestCost.SerializeForAPI(),
+		MediaInfo: &proto.QueueEntry_YoutubeVideoData{
+			YoutubeVideoData: e.serializeMediaInfoForAPI(),
+		},
+		Contributions: e.serializeContributionsForAPI(),
+	}
+	if creditedTo := e.CreditedTo(); !creditedTo.IsUnknown() {
+		entry.RequestedBy = creditedTo.SerializeForAPI()
+	}
+	return entry
+}
+
+type queueEntryYouTubeVideoJsonRepresentation struct {
+	QueueID       string
+	Type          string
+	ID            string
+	Title         string
+	ChannelTitle  string
+	ThumbnailURL  string
+	Duration      time.Duration
+	StartOffset   time.Duration `json:",omitempty"`
+	EndOffset     time.Duration `json:",omitempty"`
+	RequestedBy   string
+	RequestCost   *big.Int
+	Unskippable   bool
+	Contributions []Contribution `json:",omitempty"`
+	CreditedTo    string         `json:",omitempty"`
+	Anonymous     bool           `json:",omitempty"`
+}
+
+func (e *queueEntryYouTubeVideo) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(queueEntryYouTubeVideoJsonRepresentation{
+		QueueID:       e.queueID,
+		Type:          queueEntryTypeYouTubeVideo,
+		ID:            e.id,
+		Title:         e.title,
+		ChannelTitle:  e.channelTitle,
+		ThumbnailURL:  e.thumbnailURL,
+		Duration:      e.length,
+		StartOffset:   e.startOffset,
+		EndOffset:     e.endOffset,
+		RequestedBy:   e.requestedBy.Address(),
+		RequestCost:   e.requestCost.Int,
+		Unskippable:   e.unskippable,
+		Contributions: e.contributions,
+		CreditedTo:    e.credit.creditedToAddress(),
+		Anonymous:     e.credit.Anonymous,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.id)
+	}
+	return j, nil
+}
+
+func (e *queueEntryYouTubeVideo) UnmarshalJSON(b []byte) error {
+	var t queueEntryYouTubeVideoJsonRepresentation
+	if err := json.Unmarshal(b, &t); err != nil {
+		return stacktrace.Propagate(err, "error deserializing queue entry")
+	}
+
+	e.queueID = t.QueueID
+	e.id = t.ID
+	e.title = t.Title
+	e.channelTitle = t.ChannelTitle
+	e.thumbnailURL = t.ThumbnailURL
+	e.length = t.Duration
+	e.startOffset = t.StartOffset
+	e.endOffset = t.EndOffset
+	if e.endOffset == 0 {
+		// entries persisted before segments were supported always play the whole video
+		e.endOffset = e.startOffset + e.length
+	}
+	e.requestedBy = userFromPersistedAddress(t.RequestedBy)
+	e.requestCost = Amount{t.RequestCost}
+	e.unskippable = t.Unskippable
+	e.contributions = t.Contributions
+	e.credit = enqueueCreditFromPersisted(t.CreditedTo, t.Anonymous)
+	e.donePlaying = event.New()
+	return nil
+}
+
+func (e *queueEntryYouTubeVideo) FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket) {
+	ticket.MediaInfo = &proto.EnqueueMediaTicket_YoutubeVideoData{
+		YoutubeVideoData: e.serializeMediaInfoForAPI(),
+	}
+}
+
+func (e *queueEntryYouTubeVideo) ProduceCheckpointForAPI() *proto.MediaConsumptionCheckpoint {
+	cp := &proto.MediaConsumptionCheckpoint{
+		MediaPresent:    true,
+		CurrentPosition: durationpb.New(e.startOffset + e.PlayedFor()),
+		RequestCost:     e.requestCost.SerializeForAPI(),
+		// Reward is optionally filled outside this function
+		MediaInfo: &proto.MediaConsumptionCheckpoint_YoutubeVideoData{
+			YoutubeVideoData: &proto.NowPlayingYouTubeVideoData{
+				Id:          e.id,
+				StartOffset: durationpb.New(e.startOffset),
+				EndOffset:   durationpb.New(e.endOffset),
+			},
+		},
+	}
+	if creditedTo := e.CreditedTo(); !creditedTo.IsUnknown() {
+		cp.RequestedBy = creditedTo.SerializeForAPI()
+	}
+	return cp
+}
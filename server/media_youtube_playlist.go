@@ -0,0 +1,330 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/api/youtube/v3"
+)
+
+// maxEnqueuedPlaylistItems is the maximum number of videos considered when enqueuing a playlist.
+// Videos after this number are not enqueued, and how many there are is reported to the requester
+const maxEnqueuedPlaylistItems = 50
+
+// YouTubePlaylistMediaProvider handles the enqueuing of YouTube playlists, whose videos are enqueued together
+// under a single ticket
+type YouTubePlaylistMediaProvider struct {
+	mediaQueue *MediaQueue
+	youtube    *youtube.Service
+}
+
+var _ MediaProvider = &YouTubePlaylistMediaProvider{}
+
+// NewYouTubePlaylistMediaProvider returns a new YouTubePlaylistMediaProvider
+func NewYouTubePlaylistMediaProvider(mediaQueue *MediaQueue, youtube *youtube.Service) *YouTubePlaylistMediaProvider {
+	return &YouTubePlaylistMediaProvider{
+		mediaQueue: mediaQueue,
+		youtube:    youtube,
+	}
+}
+
+func (p *YouTubePlaylistMediaProvider) CanHandleRequestType(r *proto.EnqueueMediaRequest) bool {
+	_, ok := r.GetMediaInfo().(*proto.EnqueueMediaRequest_YoutubePlaylistData)
+	return ok
+}
+
+func (p *YouTubePlaylistMediaProvider) NewEnqueueRequest(ctx context.Context, r *proto.EnqueueMediaRequest) (EnqueueRequest, EnqueueRequestCreationResult, error) {
+	playlistID := r.GetYoutubePlaylistData().GetId()
+	if playlistID == "" {
+		return nil, EnqueueRequestCreationFailedMediumIsInvalid, nil
+	}
+
+	playlistResponse, err := p.youtube.Playlists.List([]string{"snippet"}).Id(playlistID).MaxResults(1).Do()
+	if err != nil {
+		return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	if len(playlistResponse.Items) == 0 {
+		return nil, EnqueueRequestCreationFailedMediumNotFound, nil
+	}
+	playlistItem := playlistResponse.Items[0]
+
+	itemsResponse, err := p.youtube.PlaylistItems.List([]string{"contentDetails"}).
+		PlaylistId(playlistID).MaxResults(maxEnqueuedPlaylistItems).Do()
+	if err != nil {
+		return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	omittedItemCount := 0
+	if itemsResponse.PageInfo != nil && int(itemsResponse.PageInfo.TotalResults) > len(itemsResponse.Items) {
+		omittedItemCount = int(itemsResponse.PageInfo.TotalResults) - len(itemsResponse.Items)
+	}
+	videoIDs := []string{}
+	for _, item := range itemsResponse.Items {
+		videoIDs = append(videoIDs, item.ContentDetails.VideoId)
+	}
+	if len(videoIDs) == 0 {
+		return nil, EnqueueRequestCreationFailedMediumHasNoPlayableItems, nil
+	}
+
+	videosResponse, err := p.youtube.Videos.List([]string{"snippet", "contentDetails", "status"}).
+		Id(videoIDs...).MaxResults(maxEnqueuedPlaylistItems).Do()
+	if err != nil {
+		return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	videosByID := make(map[string]*youtube.Video)
+	for _, video := range videosResponse.Items {
+		videosByID[video.Id] = video
+	}
+
+	playlist := &youTubePlaylist{
+		id:               playlistID,
+		title:            playlistItem.Snippet.Title,
+		channelTitle:     playlistItem.Snippet.ChannelTitle,
+		unskippable:      r.Unskippable,
+		requestedBy:      requesterFromContext(ctx),
+		omittedItemCount: omittedItemCount,
+	}
+	if playlistItem.Snippet.Thumbnails != nil && playlistItem.Snippet.Thumbnails.Default != nil {
+		playlist.thumbnailURL = playlistItem.Snippet.Thumbnails.Default.Url
+	}
+
+	seen := make(map[string]struct{})
+	for _, videoID := range videoIDs {
+		video, ok := videosByID[videoID]
+		if !ok {
+			// private and deleted videos remain in playlists but are not returned by the videos endpoint
+			playlist.skip(videoID, "", enqueueRequestCreationFailureReason(EnqueueRequestCreationFailedMediumNotFound))
+			continue
+		}
+		if _, duplicate := seen[videoID]; duplicate {
+			playlist.skip(videoID, video.Snippet.Title, "Video appears more than once in the playlist")
+			continue
+		}
+		seen[videoID] = struct{}{}
+
+		videoDuration, result, err := checkYouTubeVideo(video)
+		if err != nil {
+			return nil, EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+		}
+		if result == EnqueueRequestCreationSucceeded && videoDuration > maxEnqueuedMediaLength {
+			result = EnqueueRequestCreationFailedMediumIsTooLong
+		}
+		if result == EnqueueRequestCreationSucceeded && youTubeVideoInQueue(p.mediaQueue, videoID) {
+			result = EnqueueRequestCreationFailedMediumIsAlreadyInQueue
+		}
+		if result != EnqueueRequestCreationSucceeded {
+			playlist.skip(videoID, video.Snippet.Title, enqueueRequestCreationFailureReason(result))
+			continue
+		}
+
+		playlist.items = append(playlist.items,
+			newYouTubeVideoQueueEntry(video, 0, videoDuration, r.Unskippable, playlist.requestedBy))
+	}
+
+	if len(playlist.items) == 0 {
+		return nil, EnqueueRequestCreationFailedMediumHasNoPlayableItems, nil
+	}
+	return playlist, EnqueueRequestCreationSucceeded, nil
+}
+
+// youTubePlaylist is the EnqueueRequest and MediaInfo for a YouTube playlist.
+// Each video in the playlist becomes its own queue entry once the ticket is paid
+type youTubePlaylist struct {
+	id           string
+	title        string
+	channelTitle string
+	thumbnailURL string
+	unskippable  bool
+	requestedBy  User
+	items        []*queueEntryYouTubeVideo
+	skippedItems []*proto.SkippedPlaylistItem
+	// omittedItemCount is how many videos were past maxEnqueuedPlaylistItems
+	omittedItemCount int
+}
+
+var _ MediaCollection = &youTubePlaylist{}
+
+func (p *youTubePlaylist) skip(videoID, title, reason string) {
+	p.skippedItems = append(p.skippedItems, &proto.SkippedPlaylistItem{
+		Id:     videoID,
+		Title:  title,
+		Reason: reason,
+	})
+}
+
+func (p *youTubePlaylist) RequestedBy() User {
+	return p.requestedBy
+}
+
+func (p *youTubePlaylist) Unskippable() bool {
+	return p.unskippable
+}
+
+func (p *youTubePlaylist) MediaInfo() MediaInfo {
+	return p
+}
+
+func (p *youTubePlaylist) Title() string {
+	return p.title
+}
+
+func (p *youTubePlaylist) ThumbnailURL() string {
+	return p.thumbnailURL
+}
+
+func (p *youTubePlaylist) Length() time.Duration {
+	length := time.Duration(0)
+	for _, item := range p.items {
+		length += item.length
+	}
+	return length
+}
+
+func (p *youTubePlaylist) MediaID() (string, string) {
+	return mediaInfoTypeYouTubePlaylist, p.id
+}
+
+func (p *youTubePlaylist) Items() []MediaInfo {
+	items := make([]MediaInfo, len(p.items))
+	for i, item := range p.items {
+		items[i] = item
+	}
+	return items
+}
+
+func (p *youTubePlaylist) SkipItems(reasonToSkip func(MediaInfo) (string, error)) error {
+	remaining := []*queueEntryYouTubeVideo{}
+	for _, item := range p.items {
+		reason, err := reasonToSkip(item)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if reason != "" {
+			p.skip(item.id, item.title, reason)
+			continue
+		}
+		remaining = append(remaining, item)
+	}
+	p.items = remaining
+	return nil
+}
+
+// ProduceMediaQueueEntries returns one entry per video. The request cost is split among the videos in proportion to
+// their length, with any remainder going to the last video. Every video keeps the full list of contributions, as
+// each contributor's share of the cost of a video is in proportion to their contribution
+func (p *youTubePlaylist) ProduceMediaQueueEntries(requestedBy User, requestCost Amount, unskippable bool, queueID string, contributions []Contribution, credit EnqueueCredit) []MediaQueueEntry {
+	entries := make([]MediaQueueEntry, len(p.items))
+	totalLength := big.NewInt(int64(p.Length()))
+	remainingCost := new(big.Int).Set(requestCost.Int)
+	for i, item := range p.items {
+		cost := new(big.Int)
+		if i == len(p.items)-1 || totalLength.Sign() == 0 {
+			cost.Set(remainingCost)
+		} else {
+			cost.Mul(requestCost.Int, big.NewInt(int64(item.length)))
+			cost.Div(cost, totalLength)
+		}
+		remainingCost.Sub(remainingCost, cost)
+		entries[i] = item.ProduceMediaQueueEntries(requestedBy, Amount{cost}, unskippable, fmt.Sprintf("%s-%d", queueID, i), contributions, credit)[0]
+	}
+	return entries
+}
+
+func (p *youTubePlaylist) FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket) {
+	data := &proto.QueueYouTubePlaylistData{
+		Id:               p.id,
+		Title:            p.title,
+		ThumbnailUrl:     p.thumbnailURL,
+		ChannelTitle:     p.channelTitle,
+		SkippedItems:     p.skippedItems,
+		OmittedItemCount: uint32(p.omittedItemCount),
+	}
+	for _, item := range p.items {
+		data.Items = append(data.Items, item.serializeMediaInfoForAPI())
+	}
+	ticket.MediaInfo = &proto.EnqueueMediaTicket_YoutubePlaylistData{
+		YoutubePlaylistData: data,
+	}
+}
+
+type youTubePlaylistJsonRepresentation struct {
+	Type             string
+	ID               string
+	Title            string
+	ChannelTitle     string
+	ThumbnailURL     string
+	Unskippable      bool
+	RequestedBy      string
+	Items            []json.RawMessage
+	SkippedItems     []youTubePlaylistSkippedItemJsonRepresentation `json:",omitempty"`
+	OmittedItemCount int                                            `json:",omitempty"`
+}
+
+type youTubePlaylistSkippedItemJsonRepresentation struct {
+	ID     string
+	Title  string
+	Reason string
+}
+
+func (p *youTubePlaylist) MarshalJSON() ([]byte, error) {
+	t := youTubePlaylistJsonRepresentation{
+		Type:             mediaInfoTypeYouTubePlaylist,
+		ID:               p.id,
+		Title:            p.title,
+		ChannelTitle:     p.channelTitle,
+		ThumbnailURL:     p.thumbnailURL,
+		Unskippable:      p.unskippable,
+		RequestedBy:      p.requestedBy.Address(),
+		Items:            make([]json.RawMessage, len(p.items)),
+		OmittedItemCount: p.omittedItemCount,
+	}
+	for i, item := range p.items {
+		var err error
+		t.Items[i], err = item.MarshalJSON()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	for _, item := range p.skippedItems {
+		t.SkippedItems = append(t.SkippedItems, youTubePlaylistSkippedItemJsonRepresentation{
+			ID:     item.Id,
+			Title:  item.Title,
+			Reason: item.Reason,
+		})
+	}
+	j, err := json.Marshal(t)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error serializing playlist %s", p.id)
+	}
+	return j, nil
+}
+
+func (p *youTubePlaylist) UnmarshalJSON(b []byte) error {
+	var t youTubePlaylistJsonRepresentation
+	if err := json.Unmarshal(b, &t); err != nil {
+		return stacktrace.Propagate(err, "error deserializing playlist")
+	}
+
+	p.id = t.ID
+	p.title = t.Title
+	p.channelTitle = t.ChannelTitle
+	p.thumbnailURL = t.ThumbnailURL
+	p.unskippable = t.Unskippable
+	p.requestedBy = userFromPersistedAddress(t.RequestedBy)
+	p.omittedItemCount = t.OmittedItemCount
+	p.items = make([]*queueEntryYouTubeVideo, len(t.Items))
+	for i, rawItem := range t.Items {
+		p.items[i] = &queueEntryYouTubeVideo{}
+		if err := p.items[i].UnmarshalJSON(rawItem); err != nil {
+			return stacktrace.Propagate(err, "error deserializing item %d of playlist %s", i, p.id)
+		}
+	}
+	for _, item := range t.SkippedItems {
+		p.skip(item.ID, item.Title, item.Reason)
+	}
+	return nil
+}
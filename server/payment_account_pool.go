@@ -1,6 +1,12 @@
 package server
 
 import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
 	"sync"
 
 	"github.com/hectorchu/gonano/wallet"
@@ -8,39 +14,155 @@ import (
 )
 
 type PaymentAccountPool struct {
+	log               *log.Logger
 	availableAccounts map[*wallet.Account]struct{}
-	wallet            *wallet.Wallet
-	accountsMutex     sync.RWMutex
-	repAddress        string
+	// leasedAccounts contains the wallet index of the accounts handed out to tickets that are still being handled
+	leasedAccounts  map[uint32]struct{}
+	wallet          *wallet.Wallet
+	accountsMutex   sync.RWMutex
+	repAddress      string
+	persistenceFile string
 }
 
-func NewPaymentAccountPool(w *wallet.Wallet, repAddress string) *PaymentAccountPool {
-	return &PaymentAccountPool{
+type paymentAccountPoolFileContents struct {
+	// AvailableAccounts contains the wallet index of the accounts that can be handed out to new tickets
+	AvailableAccounts []uint32
+}
+
+// NewPaymentAccountPool returns a new PaymentAccountPool. If persistenceFile is not empty, the accounts available
+// for reuse are restored from it and it is kept up to date, so that accounts are not forgotten across restarts
+func NewPaymentAccountPool(log *log.Logger, w *wallet.Wallet, repAddress, persistenceFile string) (*PaymentAccountPool, error) {
+	p := &PaymentAccountPool{
+		log:               log,
 		availableAccounts: make(map[*wallet.Account]struct{}),
+		leasedAccounts:    make(map[uint32]struct{}),
 		wallet:            w,
+		repAddress:        repAddress,
+		persistenceFile:   persistenceFile,
+	}
+	if persistenceFile == "" {
+		return p, nil
+	}
+
+	b, err := ioutil.ReadFile(persistenceFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return p, nil
+		}
+		return nil, stacktrace.Propagate(err, "error reading payment account pool from file")
+	}
+	var contents paymentAccountPoolFileContents
+	err = json.Unmarshal(b, &contents)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding payment account pool from file %s", persistenceFile)
 	}
+	for _, index := range contents.AvailableAccounts {
+		account, err := p.AccountByIndex(index)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		p.availableAccounts[account] = struct{}{}
+	}
+	return p, nil
 }
 
-func (p *PaymentAccountPool) RequestAccount() (*wallet.Account, error) {
+// RequestAccount returns an account for receiving a payment, and whether it was returned to the pool before.
+// Accounts are only returned to the pool once they no longer hold any money. The account is leased until it is passed
+// to ReturnAccount or ReleaseAccount
+func (p *PaymentAccountPool) RequestAccount() (*wallet.Account, bool, error) {
 	p.accountsMutex.Lock()
 	defer p.accountsMutex.Unlock()
 
 	for a := range p.availableAccounts {
 		delete(p.availableAccounts, a)
-		return a, nil
+		p.leasedAccounts[a.Index()] = struct{}{}
+		p.persistNoMutex()
+		return a, true, nil
 	}
 
 	newAccount, err := p.wallet.NewAccount(nil)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+		return nil, false, stacktrace.Propagate(err, "")
 	}
 	newAccount.SetRep(p.repAddress)
-	return newAccount, nil
+	p.leasedAccounts[newAccount.Index()] = struct{}{}
+	return newAccount, false, nil
 }
 
+// ReturnAccount ends the lease of an account and makes it available to new tickets
 func (p *PaymentAccountPool) ReturnAccount(account *wallet.Account) {
 	p.accountsMutex.Lock()
 	defer p.accountsMutex.Unlock()
 
+	delete(p.leasedAccounts, account.Index())
 	p.availableAccounts[account] = struct{}{}
+	p.persistNoMutex()
+}
+
+// ReleaseAccount ends the lease of an account without making it available to new tickets, e.g. because funds got
+// stuck in it
+func (p *PaymentAccountPool) ReleaseAccount(account *wallet.Account) {
+	p.accountsMutex.Lock()
+	defer p.accountsMutex.Unlock()
+
+	delete(p.leasedAccounts, account.Index())
+}
+
+// IsLeased returns whether the account with the given wallet index is held by a ticket that is still being handled,
+// in which case any funds in it must be left alone
+func (p *PaymentAccountPool) IsLeased(index uint32) bool {
+	p.accountsMutex.RLock()
+	defer p.accountsMutex.RUnlock()
+
+	_, leased := p.leasedAccounts[index]
+	return leased
+}
+
+// AccountByIndex leases the payment account with the given wallet index, e.g. to restore the account of a persisted
+// ticket. The account is not taken from the available accounts
+func (p *PaymentAccountPool) AccountByIndex(index uint32) (*wallet.Account, error) {
+	account, err := p.wallet.NewAccount(&index)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error deriving payment account with index %d", index)
+	}
+	account.SetRep(p.repAddress)
+
+	p.accountsMutex.Lock()
+	defer p.accountsMutex.Unlock()
+	p.leasedAccounts[index] = struct{}{}
+	return account, nil
+}
+
+func (p *PaymentAccountPool) persistNoMutex() {
+	if p.persistenceFile == "" {
+		return
+	}
+	contents := paymentAccountPoolFileContents{
+		AvailableAccounts: []uint32{},
+	}
+	for account := range p.availableAccounts {
+		contents.AvailableAccounts = append(contents.AvailableAccounts, account.Index())
+	}
+	sort.Slice(contents.AvailableAccounts, func(i, j int) bool {
+		return contents.AvailableAccounts[i] < contents.AvailableAccounts[j]
+	})
+	err := p.writePersistenceFile(contents)
+	if err != nil {
+		// not fatal: at worst, the accounts that aren't in the file will be derived again as new accounts,
+		// at which point RegisterRequest will notice if they have leftover balance
+		p.log.Printf("error persisting payment account pool: %v", err)
+	}
+}
+
+func (p *PaymentAccountPool) writePersistenceFile(contents paymentAccountPoolFileContents) error {
+	marshalled, err := json.Marshal(contents)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing payment account pool")
+	}
+	tmpFile := p.persistenceFile + ".tmp"
+	err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error writing payment account pool to file")
+	}
+	return stacktrace.Propagate(os.Rename(tmpFile, p.persistenceFile), "error replacing payment account pool file")
 }
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PlayedMedia is a record of a queue entry that finished playing
+type PlayedMedia struct {
+	// Entry is the queue entry, serialized in the same format used for persisting the queue
+	Entry              json.RawMessage
+	MediaType          string
+	MediaID            string
+	StartedAt          time.Time
+	EndedAt            time.Time
+	PlayedFor          time.Duration
+	RewardedSpectators int
+	RewardPerSpectator *big.Int
+}
+
+// NewPlayedMedia returns a new PlayedMedia record for the given entry, which must have finished playing
+func NewPlayedMedia(entry MediaQueueEntry, rewardedSpectators int, rewardPerSpectator Amount) (PlayedMedia, error) {
+	serialized, err := entry.MarshalJSON()
+	if err != nil {
+		return PlayedMedia{}, stacktrace.Propagate(err, "")
+	}
+	mediaType, mediaID := entry.MediaInfo().MediaID()
+	now := time.Now()
+	return PlayedMedia{
+		Entry:              serialized,
+		MediaType:          mediaType,
+		MediaID:            mediaID,
+		StartedAt:          entry.StartedPlayingAt(),
+		EndedAt:            now,
+		PlayedFor:          entry.PlayedFor(),
+		RewardedSpectators: rewardedSpectators,
+		RewardPerSpectator: rewardPerSpectator.Int,
+	}, nil
+}
+
+// SerializeForAPI serializes the record for the API
+func (p PlayedMedia) SerializeForAPI() (*proto.PlayedMedia, error) {
+	entry, err := decodeQueueEntry(p.Entry)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	reward := p.RewardPerSpectator
+	if reward == nil {
+		reward = big.NewInt(0)
+	}
+	return &proto.PlayedMedia{
+		Entry:              entry.SerializeForAPI(),
+		StartedAt:          timestamppb.New(p.StartedAt),
+		EndedAt:            timestamppb.New(p.EndedAt),
+		PlayedFor:          durationpb.New(p.PlayedFor),
+		RewardedSpectators: uint32(p.RewardedSpectators),
+		RewardPerSpectator: Amount{reward}.SerializeForAPI(),
+	}, nil
+}
+
+// PlayedMediaHistoryStore saves and loads the history of played media
+type PlayedMediaHistoryStore interface {
+	StorePlayedMedia(context.Context, PlayedMedia) error
+	// LoadPlayedMedia returns played media ordered from the most to the least recent, along with the total
+	// number of records in the history
+	LoadPlayedMedia(ctx context.Context, offset, limit int) ([]PlayedMedia, int, error)
+	// LoadMediaLastPlayedAt returns when the media with the specified type and ID last finished playing.
+	// The returned time is zero if the media is not in the history
+	LoadMediaLastPlayedAt(ctx context.Context, mediaType, mediaID string) (time.Time, error)
+}
+
+// PlayedMediaHistoryStoreMemory keeps the history of played media in memory, optionally appending each record to
+// a file from which the history is restored on startup
+type PlayedMediaHistoryStoreMemory struct {
+	l       sync.RWMutex
+	history []PlayedMedia
+	// lastPlayed maps a media type and ID to the index of the most recent record for that media
+	lastPlayed map[[2]string]int
+
+	persistenceFile string
+}
+
+var _ PlayedMediaHistoryStore = &PlayedMediaHistoryStoreMemory{}
+
+// NewPlayedMediaHistoryStoreMemory returns a new PlayedMediaHistoryStoreMemory
+func NewPlayedMediaHistoryStoreMemory(persistenceFile string) (*PlayedMediaHistoryStoreMemory, error) {
+	s := &PlayedMediaHistoryStoreMemory{
+		lastPlayed:      make(map[[2]string]int),
+		persistenceFile: persistenceFile,
+	}
+	if persistenceFile != "" {
+		err := s.restoreFromFile(persistenceFile)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return s, nil
+}
+
+func (s *PlayedMediaHistoryStoreMemory) StorePlayedMedia(ctx context.Context, p PlayedMedia) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if s.persistenceFile != "" {
+		err := s.appendToFile(s.persistenceFile, p)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	s.addInMutex(p)
+	return nil
+}
+
+func (s *PlayedMediaHistoryStoreMemory) LoadPlayedMedia(ctx context.Context, offset, limit int) ([]PlayedMedia, int, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	result := []PlayedMedia{}
+	for i := len(s.history) - 1 - offset; i >= 0 && len(result) < limit; i-- {
+		result = append(result, s.history[i])
+	}
+	return result, len(s.history), nil
+}
+
+func (s *PlayedMediaHistoryStoreMemory) LoadMediaLastPlayedAt(ctx context.Context, mediaType, mediaID string) (time.Time, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	idx, present := s.lastPlayed[[2]string{mediaType, mediaID}]
+	if !present {
+		return time.Time{}, nil
+	}
+	return s.history[idx].EndedAt, nil
+}
+
+func (s *PlayedMediaHistoryStoreMemory) addInMutex(p PlayedMedia) {
+	s.history = append(s.history, p)
+	s.lastPlayed[[2]string{p.MediaType, p.MediaID}] = len(s.history) - 1
+}
+
+// appendToFile appends the record to the file as one line of JSON, so that the whole history doesn't need to be
+// rewritten every time something finishes playing
+func (s *PlayedMediaHistoryStoreMemory) appendToFile(file string, p PlayedMedia) error {
+	marshalled, err := json.Marshal(p)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error opening played media history file")
+	}
+	defer f.Close()
+	_, err = f.Write(append(marshalled, '\n'))
+	return stacktrace.Propagate(err, "error writing to played media history file")
+}
+
+func (s *PlayedMediaHistoryStoreMemory) restoreFromFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "error reading played media history from file: %v", err)
+	}
+	defer f.Close()
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var p PlayedMedia
+		err = json.Unmarshal(scanner.Bytes(), &p)
+		if err != nil {
+			return stacktrace.Propagate(err, "error decoding line %d of played media history file", line)
+		}
+		s.addInMutex(p)
+	}
+	return stacktrace.Propagate(scanner.Err(), "error reading played media history from file")
+}
@@ -0,0 +1,378 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hectorchu/gonano/wallet"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+)
+
+// prepaidBalancesFileFormatVersion is the version of the format written by persistNoMutex
+const prepaidBalancesFileFormatVersion = 1
+
+// depositAccountIndexBase is the wallet index of the first deposit account. Deposit accounts use the upper half of
+// the index space, so that they never collide with payment accounts, which are allocated sequentially from the start,
+// or with the sign-in verification representatives, which are picked from the lower half
+const depositAccountIndexBase = uint32(1) << 31
+
+// ErrInsufficientPrepaidBalance is returned when a user doesn't have enough balance for an operation
+var ErrInsufficientPrepaidBalance = errors.New("insufficient prepaid balance")
+
+// BalanceLedgerEntryType is the type of a change to a prepaid balance
+type BalanceLedgerEntryType string
+
+const (
+	// BalanceLedgerEntryTypeDeposit is used for money received by deposit accounts
+	BalanceLedgerEntryTypeDeposit BalanceLedgerEntryType = "deposit"
+	// BalanceLedgerEntryTypeTicketPayment is used for payments of enqueue tickets
+	BalanceLedgerEntryTypeTicketPayment BalanceLedgerEntryType = "ticket_payment"
+	// BalanceLedgerEntryTypeWithdrawal is used for money sent back to the reward address of the user
+	BalanceLedgerEntryTypeWithdrawal BalanceLedgerEntryType = "withdrawal"
+)
+
+// BalanceLedgerEntry is a change to the prepaid balance of a user
+type BalanceLedgerEntry struct {
+	ID      string
+	At      time.Time
+	Address string
+	Type    BalanceLedgerEntryType
+	// Amount is positive for money added to the balance and negative for money taken from it
+	Amount    *big.Int
+	TicketID  string `json:",omitempty"`
+	BlockHash string `json:",omitempty"`
+}
+
+// PrepaidBalances keeps the balances that users deposit in advance, so that they can pay for tickets instantly.
+// Each user deposits to their own deposit account, which holds the money until it is spent or withdrawn, so that the
+// on-chain balance of a deposit account always covers the balance of its user
+type PrepaidBalances struct {
+	log           *log.Logger
+	wallet        *wallet.Wallet
+	repAddress    string
+	file          string
+	confirmations *ConfirmationSubscriber
+	depositSent   chan ConfirmedBlock
+
+	mu               sync.Mutex
+	nextDepositIndex uint32
+	depositAccounts  map[string]*wallet.Account // indexed by reward address
+	owners           map[string]string          // reward address indexed by deposit account address
+	balances         map[string]*big.Int
+	entries          []BalanceLedgerEntry
+}
+
+type prepaidBalancesFileContents struct {
+	Version          int
+	NextDepositIndex uint32
+	// DepositAccounts maps reward addresses to the wallet index of their deposit account
+	DepositAccounts map[string]uint32
+	Entries         []BalanceLedgerEntry
+}
+
+// NewPrepaidBalances returns a new PrepaidBalances that is persisted to the given file
+func NewPrepaidBalances(log *log.Logger, w *wallet.Wallet, repAddress, file string, confirmations *ConfirmationSubscriber) (*PrepaidBalances, error) {
+	b := &PrepaidBalances{
+		log:              log,
+		wallet:           w,
+		repAddress:       repAddress,
+		file:             file,
+		confirmations:    confirmations,
+		depositSent:      make(chan ConfirmedBlock, 100),
+		nextDepositIndex: depositAccountIndexBase,
+		depositAccounts:  make(map[string]*wallet.Account),
+		owners:           make(map[string]string),
+		balances:         make(map[string]*big.Int),
+	}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return b, nil
+		}
+		return nil, stacktrace.Propagate(err, "error reading prepaid balances from file")
+	}
+	var decoded prepaidBalancesFileContents
+	err = json.Unmarshal(contents, &decoded)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding prepaid balances from file %s", file)
+	}
+	if decoded.Version > prepaidBalancesFileFormatVersion {
+		return nil, stacktrace.NewError("prepaid balances file format version %d is newer than the supported version %d",
+			decoded.Version, prepaidBalancesFileFormatVersion)
+	}
+
+	b.nextDepositIndex = decoded.NextDepositIndex
+	for address, index := range decoded.DepositAccounts {
+		err = b.addDepositAccountNoMutex(address, index)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	b.entries = decoded.Entries
+	for _, entry := range b.entries {
+		b.balanceNoMutex(entry.Address).Add(b.balances[entry.Address], entry.Amount)
+	}
+	return b, nil
+}
+
+func (b *PrepaidBalances) addDepositAccountNoMutex(address string, index uint32) error {
+	account, err := b.wallet.NewAccount(&index)
+	if err != nil {
+		return stacktrace.Propagate(err, "error deriving deposit account with index %d", index)
+	}
+	account.SetRep(b.repAddress)
+	b.depositAccounts[address] = account
+	b.owners[account.Address()] = address
+	if b.confirmations != nil {
+		b.confirmations.Watch(account.Address(), b.depositSent)
+	}
+	return nil
+}
+
+func (b *PrepaidBalances) balanceNoMutex(address string) *big.Int {
+	balance, ok := b.balances[address]
+	if !ok {
+		balance = big.NewInt(0)
+		b.balances[address] = balance
+	}
+	return balance
+}
+
+// DepositAccount returns the address users must send money to in order to add to their balance, creating the
+// deposit account if the user doesn't have one yet
+func (b *PrepaidBalances) DepositAccount(user User) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	account, err := b.depositAccountNoMutex(user)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "")
+	}
+	return account.Address(), nil
+}
+
+func (b *PrepaidBalances) depositAccountNoMutex(user User) (*wallet.Account, error) {
+	if account, ok := b.depositAccounts[user.Address()]; ok {
+		return account, nil
+	}
+	err := b.addDepositAccountNoMutex(user.Address(), b.nextDepositIndex)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	b.nextDepositIndex++
+	err = b.persistNoMutex()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return b.depositAccounts[user.Address()], nil
+}
+
+// Balance returns the current balance of the user
+func (b *PrepaidBalances) Balance(user User) Amount {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Amount{new(big.Int).Set(b.balanceNoMutex(user.Address()))}
+}
+
+// Statement returns the balance of the user along with a page of the changes to it, most recent first,
+// and the total number of changes
+func (b *PrepaidBalances) Statement(user User, offset, limit int) (Amount, []BalanceLedgerEntry, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	userEntries := []BalanceLedgerEntry{}
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		if b.entries[i].Address == user.Address() {
+			userEntries = append(userEntries, b.entries[i])
+		}
+	}
+	total := len(userEntries)
+	if offset > total {
+		offset = total
+	}
+	if offset+limit > total {
+		limit = total - offset
+	}
+	return Amount{new(big.Int).Set(b.balanceNoMutex(user.Address()))}, userEntries[offset : offset+limit], total
+}
+
+// PayTicket sends the price of the chosen tier of the ticket from the deposit account of the user to the payment
+// account of the ticket, from where it is handled like any other payment. Returns the hash of the send block
+func (b *PrepaidBalances) PayTicket(user User, ticket EnqueueTicket, price Amount) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	hash, err := b.debitNoMutex(user, price.Int, ticket.PaymentAccount().Address(), BalanceLedgerEntryTypeTicketPayment, ticket.ID())
+	return hash, stacktrace.Propagate(err, "")
+}
+
+// Withdraw sends the specified amount from the balance of the user to their reward address.
+// Returns the hash of the send block
+func (b *PrepaidBalances) Withdraw(user User, amount *big.Int) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	hash, err := b.debitNoMutex(user, amount, user.Address(), BalanceLedgerEntryTypeWithdrawal, "")
+	return hash, stacktrace.Propagate(err, "")
+}
+
+func (b *PrepaidBalances) debitNoMutex(user User, amount *big.Int, destination string, entryType BalanceLedgerEntryType, ticketID string) (string, error) {
+	if amount.Sign() <= 0 {
+		return "", stacktrace.NewError("amount must be positive")
+	}
+	account, ok := b.depositAccounts[user.Address()]
+	if !ok || b.balanceNoMutex(user.Address()).Cmp(amount) < 0 {
+		return "", stacktrace.Propagate(ErrInsufficientPrepaidBalance, "")
+	}
+
+	hash, err := account.Send(destination, amount)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "failed to send %v from deposit account %v to %v", amount, account.Address(), destination)
+	}
+	b.recordNoMutex(BalanceLedgerEntry{
+		Address:   user.Address(),
+		Type:      entryType,
+		Amount:    new(big.Int).Neg(amount),
+		TicketID:  ticketID,
+		BlockHash: hash.String(),
+	})
+	return hash.String(), nil
+}
+
+func (b *PrepaidBalances) recordNoMutex(entry BalanceLedgerEntry) {
+	entry.ID = uuid.NewV4().String()
+	entry.At = time.Now()
+	b.entries = append(b.entries, entry)
+	b.balanceNoMutex(entry.Address).Add(b.balances[entry.Address], entry.Amount)
+	b.log.Printf("Prepaid balance of %s changed by %v (%s, ticket: %s, block: %s)",
+		entry.Address, entry.Amount, entry.Type, entry.TicketID, entry.BlockHash)
+	err := b.persistNoMutex()
+	if err != nil {
+		// the money has already moved, so there's no going back. The entry is kept in memory and will be written
+		// along with the next change
+		b.log.Printf("error persisting prepaid balances: %v", err)
+	}
+}
+
+// CheckDeposits credits the user with the money received by their deposit account since it was last checked
+func (b *PrepaidBalances) CheckDeposits(user User) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	account, ok := b.depositAccounts[user.Address()]
+	if !ok {
+		return nil
+	}
+	return stacktrace.Propagate(b.checkDepositsNoMutex(user.Address(), account), "")
+}
+
+func (b *PrepaidBalances) checkDepositsNoMutex(address string, account *wallet.Account) error {
+	// only confirmed receivables are received, so this is safe to credit
+	err := account.ReceivePendings()
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to receive pendings in deposit account %v", account.Address())
+	}
+	onChain, _, err := account.Balance()
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to check balance of deposit account %v", account.Address())
+	}
+	deposited := new(big.Int).Sub(onChain, b.balanceNoMutex(address))
+	if deposited.Sign() <= 0 {
+		return nil
+	}
+	b.recordNoMutex(BalanceLedgerEntry{
+		Address: address,
+		Type:    BalanceLedgerEntryTypeDeposit,
+		Amount:  deposited,
+	})
+	return nil
+}
+
+// Worker credits deposits as soon as they are confirmed and, in case a confirmation is missed, checks all deposit
+// accounts every interval
+func (b *PrepaidBalances) Worker(ctx context.Context, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			err := b.checkAllDeposits()
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		case block := <-b.depositSent:
+			err := func() error {
+				b.mu.Lock()
+				defer b.mu.Unlock()
+				owner, ok := b.owners[block.LinkAsAccount]
+				if !ok || !block.IsPaymentTo(block.LinkAsAccount) {
+					return nil
+				}
+				return stacktrace.Propagate(b.checkDepositsNoMutex(owner, b.depositAccounts[owner]), "")
+			}()
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (b *PrepaidBalances) checkAllDeposits() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.depositAccounts) == 0 {
+		return nil
+	}
+	addresses := make([]string, 0, len(b.owners))
+	for address := range b.owners {
+		addresses = append(addresses, address)
+	}
+	// a single request for all accounts, so that only the accounts which received money are looked at in detail
+	onChain, err := b.wallet.RPC.AccountsBalances(addresses)
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to check balances of deposit accounts")
+	}
+	for address, balance := range onChain {
+		owner, ok := b.owners[address]
+		if !ok || balance == nil || balance.Balance == nil || balance.Pending == nil {
+			continue
+		}
+		total := new(big.Int).Add(&balance.Balance.Int, &balance.Pending.Int)
+		if total.Cmp(b.balanceNoMutex(owner)) <= 0 {
+			continue
+		}
+		err = b.checkDepositsNoMutex(owner, b.depositAccounts[owner])
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+func (b *PrepaidBalances) persistNoMutex() error {
+	contents := prepaidBalancesFileContents{
+		Version:          prepaidBalancesFileFormatVersion,
+		NextDepositIndex: b.nextDepositIndex,
+		DepositAccounts:  make(map[string]uint32, len(b.depositAccounts)),
+		Entries:          b.entries,
+	}
+	for address, account := range b.depositAccounts {
+		contents.DepositAccounts[address] = account.Index()
+	}
+	marshalled, err := json.Marshal(contents)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing prepaid balances")
+	}
+	tmpFile := b.file + ".tmp"
+	err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error writing prepaid balances to file")
+	}
+	return stacktrace.Propagate(os.Rename(tmpFile, b.file), "error replacing prepaid balances file")
+}
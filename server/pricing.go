@@ -1,18 +1,26 @@
 package server
 
 import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"math"
 	"math/big"
+	"os"
+	"sort"
+	"sync"
 	"time"
+
+	"github.com/palantir/stacktrace"
 )
 
 // BananoUnit is 1 BAN
 var BananoUnit *big.Int = big.NewInt(1).Exp(big.NewInt(10), big.NewInt(29), big.NewInt(0)) // 100000000000000000000000000000
 
-// BaseEnqueuePrice is the price to enqueue on an empty queue
+// BaseEnqueuePrice is the default price to enqueue on an empty queue
 var BaseEnqueuePrice *big.Int = new(big.Int).Div(BananoUnit, big.NewInt(2))
 
-// PriceRoundingFactor is the rounding factor for enqueue prices
+// PriceRoundingFactor is the default rounding factor for enqueue prices
 var PriceRoundingFactor *big.Int = new(big.Int).Div(BananoUnit, big.NewInt(100))
 
 // RewardRoundingFactor is the rounding factor for per-user rewards
@@ -25,65 +33,333 @@ type EnqueuePricing struct {
 	PlayNowPrice  Amount
 }
 
-// ComputeEnqueuePricing calculates the prices to charge for a new queue entry considering the current queue conditions
-func ComputeEnqueuePricing(mediaQueue *MediaQueue, currentlyWatching int, videoDuration time.Duration, unskippable bool) EnqueuePricing {
-	// QueueLength = max(0, actual queue length - 1)
-	// QueueLengthFactor = floor(100 * (QueueLength to the power of 1.2))
-	// LengthPenalty is 0 for videos under 6 minutes, 1 for videos with [6, 10[ minutes, 5 for videos with [10, 14[ minutes, 12 for videos with [14, 20[ minutes, 20 for videos with [20, 25[ minutes, 40 for videos with [25, 30] minutes
-	// UnskippableFactor is 19 if unskippable, else 0
-	// EnqueuePrice = BaseEnqueuePrice * (1 + (QueueLengthFactor/10) + (currentlyWatching * 0.06) + LengthPenalty) * UnskippableFactor
-	// or: EnqueuePrice = ( BaseEnqueuePrice * (1000 + QueueLengthFactor + currentlyWatching * 60 + LengthPenalty * 1000) ) / 1000 * UnskippableFactor
-	// PlayNextPrice = EnqueuePrice * 3
-	// PlayNowPrice = EnqueuePrice * 10
-	queueLength := mediaQueue.Length() - 1
-	if queueLength < 0 {
-		queueLength = 0
+// PricingConditions are the conditions of the queue that enqueue prices depend on
+type PricingConditions struct {
+	// QueueLength is the number of entries that will play before the new entry, not counting the one currently playing
+	QueueLength       int
+	CurrentlyWatching int
+}
+
+// PricingStrategy calculates the prices to charge for enqueuing media
+type PricingStrategy interface {
+	// ComputeEnqueuePricing calculates the prices for an item with the given length
+	ComputeEnqueuePricing(conditions PricingConditions, length time.Duration, unskippable bool) EnqueuePricing
+	// Parameters returns the parameters of the strategy, in the format accepted by its constructor
+	Parameters() (json.RawMessage, error)
+}
+
+// DefaultPricingStrategyName is the name of the pricing strategy used when none is configured
+const DefaultPricingStrategyName = "default"
+
+// pricingStrategies maps the name of each pricing strategy to the function that builds it from its parameters.
+// Empty parameters result in the default parameters of the strategy
+var pricingStrategies = map[string]func(parameters json.RawMessage) (PricingStrategy, error){
+	DefaultPricingStrategyName: NewDefaultPricingStrategy,
+}
+
+// PricingStrategyNames returns the names of the available pricing strategies, in alphabetical order
+func PricingStrategyNames() []string {
+	names := make([]string, 0, len(pricingStrategies))
+	for name := range pricingStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultPricingParameters are the parameters of DefaultPricingStrategy
+type DefaultPricingParameters struct {
+	// BaseEnqueuePrice is the price to enqueue a short video on an empty queue with no spectators, in raw units
+	BaseEnqueuePrice *big.Int
+	// QueueLengthFactor and QueueLengthExponent determine how the price grows with the queue length
+	QueueLengthFactor   float64
+	QueueLengthExponent float64
+	// CurrentlyWatchingFactor determines how the price grows with the number of spectators
+	CurrentlyWatchingFactor int64
+	// LengthPenalties must be ordered by increasing MinimumLength
+	LengthPenalties       []LengthPenalty
+	UnskippableMultiplier int64
+	PlayNextMultiplier    int64
+	PlayNowMultiplier     int64
+	// RoundingFactor is what enqueue prices are rounded down to a multiple of, in raw units
+	RoundingFactor *big.Int
+}
+
+// LengthPenalty is added to the price of items at least as long as MinimumLength, in multiples of the base price
+type LengthPenalty struct {
+	MinimumLength Duration
+	Penalty       int64
+}
+
+// Duration is a time.Duration that is represented in JSON as a string like "4m30s", for files edited by hand
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	parsed, err := time.ParseDuration(str)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// DefaultPricingStrategy is the pricing formula JungleTV has always used:
+// QueueLengthFactor = floor(QueueLengthFactor * (QueueLength to the power of QueueLengthExponent))
+// LengthPenalty is the penalty of the longest MinimumLength the item length reaches, or 0
+// EnqueuePrice = BaseEnqueuePrice * (1000 + QueueLengthFactor + currentlyWatching * CurrentlyWatchingFactor + LengthPenalty * 1000) / 1000
+// EnqueuePrice is multiplied by UnskippableMultiplier for unskippable items, then rounded down to RoundingFactor
+// PlayNextPrice = EnqueuePrice * PlayNextMultiplier
+// PlayNowPrice = EnqueuePrice * PlayNowMultiplier
+type DefaultPricingStrategy struct {
+	params DefaultPricingParameters
+}
+
+// defaultPricingParameters returns the parameters JungleTV used before prices were configurable
+func defaultPricingParameters() DefaultPricingParameters {
+	return DefaultPricingParameters{
+		BaseEnqueuePrice:        new(big.Int).Set(BaseEnqueuePrice),
+		QueueLengthFactor:       100,
+		QueueLengthExponent:     1.2,
+		CurrentlyWatchingFactor: 100,
+		LengthPenalties: []LengthPenalty{
+			{MinimumLength: Duration(4*time.Minute + 30*time.Second), Penalty: 2},
+			{MinimumLength: Duration(6 * time.Minute), Penalty: 4},
+			{MinimumLength: Duration(10 * time.Minute), Penalty: 10},
+			{MinimumLength: Duration(14 * time.Minute), Penalty: 24},
+			{MinimumLength: Duration(20 * time.Minute), Penalty: 30},
+			{MinimumLength: Duration(25 * time.Minute), Penalty: 60},
+		},
+		UnskippableMultiplier: 19,
+		PlayNextMultiplier:    3,
+		PlayNowMultiplier:     10,
+		RoundingFactor:        new(big.Int).Set(PriceRoundingFactor),
+	}
+}
+
+// NewDefaultPricingStrategy returns a DefaultPricingStrategy with the given parameters, encoded as
+// DefaultPricingParameters. Parameters that are omitted keep their default values
+func NewDefaultPricingStrategy(parameters json.RawMessage) (PricingStrategy, error) {
+	params := defaultPricingParameters()
+	if len(parameters) > 0 {
+		err := json.Unmarshal(parameters, &params)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "invalid parameters")
+		}
 	}
-	queueLengthFactor := int64(100.0 * math.Pow(float64(queueLength), 1.2))
 
-	lengthPenalty := 0
 	switch {
-	case videoDuration.Minutes() >= 25:
-		lengthPenalty = 60
-	case videoDuration.Minutes() >= 20:
-		lengthPenalty = 30
-	case videoDuration.Minutes() >= 14:
-		lengthPenalty = 24
-	case videoDuration.Minutes() >= 10:
-		lengthPenalty = 10
-	case videoDuration.Minutes() >= 6:
-		lengthPenalty = 4
-	case videoDuration.Minutes() >= 4.5:
-		lengthPenalty = 2
+	case params.BaseEnqueuePrice == nil || params.BaseEnqueuePrice.Sign() <= 0:
+		return nil, stacktrace.NewError("the base enqueue price must be positive")
+	case params.RoundingFactor == nil || params.RoundingFactor.Sign() <= 0:
+		return nil, stacktrace.NewError("the rounding factor must be positive")
+	case params.QueueLengthFactor < 0 || params.QueueLengthExponent < 0 || params.CurrentlyWatchingFactor < 0:
+		return nil, stacktrace.NewError("the queue length and spectator factors must not be negative")
+	case params.UnskippableMultiplier < 1 || params.PlayNextMultiplier < 1 || params.PlayNowMultiplier < 1:
+		return nil, stacktrace.NewError("the multipliers must be at least 1")
+	}
+	for i, penalty := range params.LengthPenalties {
+		if penalty.Penalty < 0 {
+			return nil, stacktrace.NewError("length penalties must not be negative")
+		}
+		if i > 0 && penalty.MinimumLength <= params.LengthPenalties[i-1].MinimumLength {
+			return nil, stacktrace.NewError("length penalties must be ordered by increasing minimum length")
+		}
+	}
+	return &DefaultPricingStrategy{params: params}, nil
+}
+
+// Parameters implements PricingStrategy
+func (d *DefaultPricingStrategy) Parameters() (json.RawMessage, error) {
+	b, err := json.Marshal(d.params)
+	return b, stacktrace.Propagate(err, "")
+}
+
+// ComputeEnqueuePricing implements PricingStrategy
+func (d *DefaultPricingStrategy) ComputeEnqueuePricing(conditions PricingConditions, length time.Duration, unskippable bool) EnqueuePricing {
+	queueLengthFactor := int64(d.params.QueueLengthFactor * math.Pow(float64(conditions.QueueLength), d.params.QueueLengthExponent))
+
+	lengthPenalty := int64(0)
+	for _, penalty := range d.params.LengthPenalties {
+		if length >= time.Duration(penalty.MinimumLength) {
+			lengthPenalty = penalty.Penalty
+		}
 	}
 
 	pricing := EnqueuePricing{}
 
 	pricing.EnqueuePrice = Amount{new(big.Int)}
-	pricing.EnqueuePrice.Set(BaseEnqueuePrice)
+	pricing.EnqueuePrice.Set(d.params.BaseEnqueuePrice)
 	m := big.NewInt(1000).Add(big.NewInt(1000), big.NewInt(queueLengthFactor))
-	m = m.Add(m, big.NewInt(int64(currentlyWatching*100)))
-	m = m.Add(m, big.NewInt(int64(lengthPenalty*1000)))
+	m = m.Add(m, big.NewInt(int64(conditions.CurrentlyWatching)*d.params.CurrentlyWatchingFactor))
+	m = m.Add(m, big.NewInt(lengthPenalty*1000))
 	pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, m)
 	pricing.EnqueuePrice.Div(pricing.EnqueuePrice.Int, big.NewInt(1000))
 	if unskippable {
-		pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, big.NewInt(19))
+		pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, big.NewInt(d.params.UnskippableMultiplier))
 	}
 
-	pricing.EnqueuePrice.Div(pricing.EnqueuePrice.Int, PriceRoundingFactor)
-	pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, PriceRoundingFactor)
+	pricing.EnqueuePrice.Div(pricing.EnqueuePrice.Int, d.params.RoundingFactor)
+	pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, d.params.RoundingFactor)
 
 	pricing.PlayNextPrice = Amount{new(big.Int)}
 	pricing.PlayNextPrice.Set(pricing.EnqueuePrice.Int)
-	pricing.PlayNextPrice.Mul(pricing.PlayNextPrice.Int, big.NewInt(3))
+	pricing.PlayNextPrice.Mul(pricing.PlayNextPrice.Int, big.NewInt(d.params.PlayNextMultiplier))
 
 	pricing.PlayNowPrice = Amount{new(big.Int)}
 	pricing.PlayNowPrice.Set(pricing.EnqueuePrice.Int)
-	pricing.PlayNowPrice.Mul(pricing.PlayNowPrice.Int, big.NewInt(10))
+	pricing.PlayNowPrice.Mul(pricing.PlayNowPrice.Int, big.NewInt(d.params.PlayNowMultiplier))
 
 	return pricing
 }
 
+// Pricer holds the pricing strategy currently in use, which can be replaced at runtime
+type Pricer struct {
+	mu           sync.RWMutex
+	file         string
+	strategyName string
+	strategy     PricingStrategy
+}
+
+type pricingFileContents struct {
+	Strategy   string
+	Parameters json.RawMessage `json:",omitempty"`
+}
+
+// NewPricer returns a new Pricer using the strategy and parameters in the given file.
+// If the file is not specified or does not exist, the default strategy is used with its default parameters
+func NewPricer(file string) (*Pricer, error) {
+	p := &Pricer{
+		file: file,
+	}
+	contents := pricingFileContents{
+		Strategy: DefaultPricingStrategyName,
+	}
+	if file != "" {
+		b, err := ioutil.ReadFile(file)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, stacktrace.Propagate(err, "error reading pricing file")
+		}
+		if err == nil {
+			err = json.Unmarshal(b, &contents)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "error decoding pricing file %s", file)
+			}
+		}
+	}
+
+	var err error
+	p.strategyName = contents.Strategy
+	p.strategy, err = buildPricingStrategy(contents.Strategy, contents.Parameters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return p, nil
+}
+
+func buildPricingStrategy(name string, parameters json.RawMessage) (PricingStrategy, error) {
+	newStrategy, ok := pricingStrategies[name]
+	if !ok {
+		return nil, stacktrace.NewError("unknown pricing strategy \"%s\"", name)
+	}
+	strategy, err := newStrategy(parameters)
+	return strategy, stacktrace.Propagate(err, "")
+}
+
+// Strategy returns the name of the pricing strategy in use, along with its parameters
+func (p *Pricer) Strategy() (string, json.RawMessage, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	parameters, err := p.strategy.Parameters()
+	return p.strategyName, parameters, stacktrace.Propagate(err, "")
+}
+
+// SetStrategy replaces the pricing strategy, saving it to the file if there is one. Tickets that were already
+// created keep their prices
+func (p *Pricer) SetStrategy(name string, parameters json.RawMessage) error {
+	strategy, err := buildPricingStrategy(name, parameters)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file != "" {
+		// save the parameters as understood by the strategy, so that the file shows the ones that were omitted
+		contents := pricingFileContents{
+			Strategy: name,
+		}
+		contents.Parameters, err = strategy.Parameters()
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		marshalled, err := json.MarshalIndent(contents, "", "  ")
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		tmpFile := p.file + ".tmp"
+		err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+		if err != nil {
+			return stacktrace.Propagate(err, "error writing pricing to file")
+		}
+		err = os.Rename(tmpFile, p.file)
+		if err != nil {
+			return stacktrace.Propagate(err, "error replacing pricing file")
+		}
+	}
+	p.strategyName = name
+	p.strategy = strategy
+	return nil
+}
+
+// ComputeMediaEnqueuePricing calculates the prices to charge for the given media considering the current queue
+// conditions. Media consisting of multiple items, such as playlists, costs the same as enqueuing each of its items
+// individually, i.e. the combined price reflects the combined duration while keeping the penalty for long items
+func (p *Pricer) ComputeMediaEnqueuePricing(mediaQueue *MediaQueue, currentlyWatching int, mediaInfo MediaInfo, unskippable bool) EnqueuePricing {
+	p.mu.RLock()
+	strategy := p.strategy
+	p.mu.RUnlock()
+
+	// QueueLength = max(0, actual queue length - 1) + number of scheduled entries that will play before the new entry
+	conditions := PricingConditions{
+		QueueLength:       mediaQueue.Length() - 1,
+		CurrentlyWatching: currentlyWatching,
+	}
+	if conditions.QueueLength < 0 {
+		conditions.QueueLength = 0
+	}
+	conditions.QueueLength += mediaQueue.ScheduledEntriesAhead()
+
+	collection, ok := mediaInfo.(MediaCollection)
+	if !ok {
+		return strategy.ComputeEnqueuePricing(conditions, mediaInfo.Length(), unskippable)
+	}
+
+	pricing := EnqueuePricing{
+		EnqueuePrice:  Amount{new(big.Int)},
+		PlayNextPrice: Amount{new(big.Int)},
+		PlayNowPrice:  Amount{new(big.Int)},
+	}
+	for _, item := range collection.Items() {
+		itemPricing := strategy.ComputeEnqueuePricing(conditions, item.Length(), unskippable)
+		pricing.EnqueuePrice.Add(pricing.EnqueuePrice.Int, itemPricing.EnqueuePrice.Int)
+		pricing.PlayNextPrice.Add(pricing.PlayNextPrice.Int, itemPricing.PlayNextPrice.Int)
+		pricing.PlayNowPrice.Add(pricing.PlayNowPrice.Int, itemPricing.PlayNowPrice.Int)
+	}
+	return pricing
+}
+
 // ComputeReward calculates how much each user should receive
 func ComputeReward(totalAmount Amount, numUsers int) Amount {
 	amountForEach := Amount{new(big.Int)}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"github.com/tnyim/jungletv/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// maxQueueChangesPerUpdate is the number of changes above which a snapshot is sent instead
+const maxQueueChangesPerUpdate = 25
+
+// computeQueueChanges returns the changes that turn the previous list of entries into the current one.
+// Projected start times are not compared, as they are sent separately.
+// Returns false if sending a snapshot is preferable
+func computeQueueChanges(previous, current []*proto.QueueEntry) ([]*proto.QueueChange, bool) {
+	changes := []*proto.QueueChange{}
+
+	currentByID := make(map[string]*proto.QueueEntry, len(current))
+	for _, entry := range current {
+		currentByID[entry.Id] = entry
+	}
+
+	headAdvancedBy := 0
+	for headAdvancedBy < len(previous) && currentByID[previous[headAdvancedBy].Id] == nil {
+		headAdvancedBy++
+	}
+	if headAdvancedBy > 0 {
+		changes = append(changes, &proto.QueueChange{
+			Change: &proto.QueueChange_HeadAdvanced{
+				HeadAdvanced: &proto.QueueHeadAdvanced{
+					Count: uint32(headAdvancedBy),
+				},
+			},
+		})
+	}
+
+	working := []*proto.QueueEntry{}
+	for _, entry := range previous[headAdvancedBy:] {
+		if currentByID[entry.Id] == nil {
+			changes = append(changes, &proto.QueueChange{
+				Change: &proto.QueueChange_Removed{
+					Removed: &proto.QueueEntryRemoved{
+						Id: entry.Id,
+					},
+				},
+			})
+			continue
+		}
+		working = append(working, entry)
+	}
+
+	for i, entry := range current {
+		if len(changes) > maxQueueChangesPerUpdate {
+			return nil, false
+		}
+		if i < len(working) && working[i].Id == entry.Id {
+			if !queueEntriesEqual(working[i], entry) {
+				changes = append(changes, &proto.QueueChange{
+					Change: &proto.QueueChange_Updated{
+						Updated: &proto.QueueEntryUpdated{
+							Entry: entry,
+						},
+					},
+				})
+			}
+			continue
+		}
+
+		from := -1
+		for j := i + 1; j < len(working); j++ {
+			if working[j].Id == entry.Id {
+				from = j
+				break
+			}
+		}
+		if from < 0 {
+			changes = append(changes, &proto.QueueChange{
+				Change: &proto.QueueChange_Inserted{
+					Inserted: &proto.QueueEntryInserted{
+						Position: uint32(i),
+						Entry:    entry,
+					},
+				},
+			})
+			working = append(working[:i], append([]*proto.QueueEntry{entry}, working[i:]...)...)
+			continue
+		}
+
+		changes = append(changes, &proto.QueueChange{
+			Change: &proto.QueueChange_Moved{
+				Moved: &proto.QueueEntryMoved{
+					Id:       entry.Id,
+					Position: uint32(i),
+				},
+			},
+		})
+		if !queueEntriesEqual(working[from], entry) {
+			changes = append(changes, &proto.QueueChange{
+				Change: &proto.QueueChange_Updated{
+					Updated: &proto.QueueEntryUpdated{
+						Entry: entry,
+					},
+				},
+			})
+		}
+		working = append(working[:from], working[from+1:]...)
+		working = append(working[:i], append([]*proto.QueueEntry{entry}, working[i:]...)...)
+	}
+	if len(changes) > maxQueueChangesPerUpdate || len(changes) >= len(current) && len(current) > 0 {
+		return nil, false
+	}
+	return changes, true
+}
+
+func queueEntriesEqual(a, b *proto.QueueEntry) bool {
+	aStart, bStart := a.ProjectedStart, b.ProjectedStart
+	a.ProjectedStart, b.ProjectedStart = nil, nil
+	defer func() {
+		a.ProjectedStart, b.ProjectedStart = aStart, bStart
+	}()
+	return protobuf.Equal(a, b)
+}
+
+func scheduledMediaEqual(a, b []*proto.ScheduledMedia) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !protobuf.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tnyim/jungletv/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func testQueueEntries(ids ...string) []*proto.QueueEntry {
+	entries := make([]*proto.QueueEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = &proto.QueueEntry{
+			Id:          id,
+			RequestCost: "1000",
+		}
+	}
+	return entries
+}
+
+// applyQueueChanges applies the changes the way clients do, failing the test if a change doesn't make sense
+func applyQueueChanges(t *testing.T, entries []*proto.QueueEntry, changes []*proto.QueueChange) []*proto.QueueEntry {
+	t.Helper()
+	entries = append([]*proto.QueueEntry{}, entries...)
+	indexOf := func(id string) int {
+		for i, entry := range entries {
+			if entry.Id == id {
+				return i
+			}
+		}
+		t.Fatalf("change refers to missing entry %s", id)
+		return -1
+	}
+	for _, change := range changes {
+		switch c := change.Change.(type) {
+		case *proto.QueueChange_HeadAdvanced:
+			if int(c.HeadAdvanced.Count) > len(entries) {
+				t.Fatalf("head advanced by %d with %d entries", c.HeadAdvanced.Count, len(entries))
+			}
+			entries = entries[c.HeadAdvanced.Count:]
+		case *proto.QueueChange_Removed:
+			i := indexOf(c.Removed.Id)
+			entries = append(entries[:i], entries[i+1:]...)
+		case *proto.QueueChange_Inserted:
+			position := int(c.Inserted.Position)
+			if position > len(entries) {
+				t.Fatalf("insertion at %d with %d entries", position, len(entries))
+			}
+			entries = append(entries[:position], append([]*proto.QueueEntry{c.Inserted.Entry}, entries[position:]...)...)
+		case *proto.QueueChange_Moved:
+			i := indexOf(c.Moved.Id)
+			entry := entries[i]
+			entries = append(entries[:i], entries[i+1:]...)
+			position := int(c.Moved.Position)
+			if position > len(entries) {
+				t.Fatalf("move to %d with %d other entries", position, len(entries))
+			}
+			entries = append(entries[:position], append([]*proto.QueueEntry{entry}, entries[position:]...)...)
+		case *proto.QueueChange_Updated:
+			entries[indexOf(c.Updated.Entry.Id)] = c.Updated.Entry
+		default:
+			t.Fatalf("unknown change %T", change.Change)
+		}
+	}
+	return entries
+}
+
+func TestComputeQueueChanges(t *testing.T) {
+	pinned := testQueueEntries("a", "b", "c", "d")
+	pinned[1].Pinned = true
+	movedAndPinned := testQueueEntries("a", "d", "b", "c", "e")
+	movedAndPinned[1].Pinned = true
+
+	manyIDs := []string{}
+	for i := 0; i < maxQueueChangesPerUpdate+5; i++ {
+		manyIDs = append(manyIDs, fmt.Sprintf("new%d", i))
+	}
+
+	tests := []struct {
+		name            string
+		previous        []*proto.QueueEntry
+		current         []*proto.QueueEntry
+		expectSnapshot  bool
+		expectedChanges int
+	}{
+		{
+			name:            "no changes",
+			previous:        testQueueEntries("a", "b", "c"),
+			current:         testQueueEntries("a", "b", "c"),
+			expectedChanges: 0,
+		},
+		{
+			name:            "head advance and insert",
+			previous:        testQueueEntries("a", "b", "c", "d", "e"),
+			current:         testQueueEntries("c", "d", "x", "e", "f"),
+			expectedChanges: 3,
+		},
+		{
+			name:            "move past removed entries",
+			previous:        testQueueEntries("a", "b", "c", "d", "e", "f"),
+			current:         testQueueEntries("a", "f", "c", "e"),
+			expectedChanges: 3,
+		},
+		{
+			name:            "pin only",
+			previous:        testQueueEntries("a", "b", "c", "d"),
+			current:         pinned,
+			expectedChanges: 1,
+		},
+		{
+			name:            "move and pin",
+			previous:        testQueueEntries("a", "b", "c", "d", "e"),
+			current:         movedAndPinned,
+			expectedChanges: 2,
+		},
+		{
+			name:           "too many changes",
+			previous:       testQueueEntries("a", "b"),
+			current:        testQueueEntries(append([]string{"a", "b"}, manyIDs...)...),
+			expectSnapshot: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes, ok := computeQueueChanges(test.previous, test.current)
+			if test.expectSnapshot {
+				if ok {
+					t.Fatalf("expected a snapshot, got %d changes", len(changes))
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("expected changes, got a snapshot")
+			}
+			if len(changes) != test.expectedChanges {
+				t.Errorf("expected %d changes, got %d: %v", test.expectedChanges, len(changes), changes)
+			}
+			result := applyQueueChanges(t, test.previous, changes)
+			if len(result) != len(test.current) {
+				t.Fatalf("expected %d entries after applying the changes, got %d", len(test.current), len(result))
+			}
+			for i := range result {
+				if !protobuf.Equal(result[i], test.current[i]) {
+					t.Fatalf("entry %d is %v after applying the changes, expected %v", i, result[i], test.current[i])
+				}
+			}
+		})
+	}
+}
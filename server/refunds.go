@@ -0,0 +1,279 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hectorchu/gonano/wallet"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/alexcesaro/statsd.v2"
+)
+
+// RefundReason is the reason why money was returned to whoever sent it
+type RefundReason string
+
+const (
+	// RefundReasonLatePayment is used for payments received by a payment account after its ticket was gone
+	RefundReasonLatePayment RefundReason = "late_payment"
+	// RefundReasonInsufficientPayment is used for payments below the enqueue price of a ticket that expired
+	RefundReasonInsufficientPayment RefundReason = "insufficient_payment"
+	// RefundReasonExcessPayment is used for the amount paid above the price of the tier that was met
+	RefundReasonExcessPayment RefundReason = "excess_payment"
+	// RefundReasonUnfundedCrowdfunding is used for the contributions to crowdfunded tickets that expired before their
+	// target tier was reached
+	RefundReasonUnfundedCrowdfunding RefundReason = "unfunded_crowdfunding"
+	// RefundReasonUnhandledBalance is used for balances found in payment accounts that were never handed out,
+	// which can't be attributed to a ticket
+	RefundReasonUnhandledBalance RefundReason = "unhandled_balance"
+)
+
+// refundSenderHistoryLength is how many blocks of the history of a payment account are looked at to find who sent
+// the money being refunded
+const refundSenderHistoryLength = 20
+
+// RefundPolicy configures which refunds are issued besides the full refunds of late and insufficient payments
+type RefundPolicy struct {
+	// RefundExcess is whether the amount paid above the price of the tier that was met is refunded
+	RefundExcess bool
+	// MinimumExcess is the excess amount below which the excess is kept, as it is not worth sending back
+	MinimumExcess Amount
+}
+
+// Refunder sends money received by payment accounts back to whoever sent it, recording every refund in a ledger
+type Refunder struct {
+	log         *log.Logger
+	statsClient *statsd.Client
+	wallet      *wallet.Wallet
+	policy      RefundPolicy
+
+	ledgerFile  string
+	ledgerMutex sync.Mutex
+}
+
+// RefundLedgerEntry is a refund recorded in the ledger. A refund split among multiple senders results in one entry
+// per sender
+type RefundLedgerEntry struct {
+	ID             string
+	RefundedAt     time.Time
+	Reason         RefundReason
+	TicketID       string `json:",omitempty"`
+	PaymentAccount string
+	Recipient      string
+	Amount         *big.Int
+	BlockHash      string
+}
+
+// NewRefunder returns a new Refunder. Refunds are appended to ledgerFile, one JSON object per line.
+// If ledgerFile is empty, refunds are only written to the log
+func NewRefunder(log *log.Logger, statsClient *statsd.Client, w *wallet.Wallet, policy RefundPolicy, ledgerFile string) *Refunder {
+	if policy.MinimumExcess.Int == nil {
+		policy.MinimumExcess = Amount{big.NewInt(0)}
+	}
+	return &Refunder{
+		log:         log,
+		statsClient: statsClient,
+		wallet:      w,
+		policy:      policy,
+		ledgerFile:  ledgerFile,
+	}
+}
+
+// ExcessToRefund returns how much of the amount paid should be refunded, given the price of the tier that was met
+func (r *Refunder) ExcessToRefund(paid, price *big.Int) *big.Int {
+	excess := new(big.Int).Sub(paid, price)
+	if !r.policy.RefundExcess || excess.Sign() <= 0 || excess.Cmp(r.policy.MinimumExcess.Int) < 0 {
+		return big.NewInt(0)
+	}
+	return excess
+}
+
+// Refund sends the specified amount from the payment account back to the senders of the most recent payments it
+// received. Returns how much was refunded, which is less than the specified amount if an error occurred halfway
+func (r *Refunder) Refund(account *wallet.Account, amount *big.Int, reason RefundReason, ticketID string) (*big.Int, error) {
+	allocations, err := r.planRefund(account, amount)
+	if err != nil {
+		return big.NewInt(0), stacktrace.Propagate(err, "")
+	}
+	refunded, _, err := r.sendRefunds(account, allocations, reason, ticketID)
+	return refunded, stacktrace.Propagate(err, "")
+}
+
+// RefundContributors sends the specified amount from the payment account of a crowdfunded ticket back to its
+// contributors, in proportion to how much each one contributed.
+// Returns how much was refunded, which is less than the specified amount if an error occurred halfway
+func (r *Refunder) RefundContributors(account *wallet.Account, amount *big.Int, reason RefundReason, ticketID string) (*big.Int, error) {
+	allocations, err := r.planContributorsRefund(account, amount)
+	if err != nil {
+		return big.NewInt(0), stacktrace.Propagate(err, "")
+	}
+	refunded, _, err := r.sendRefunds(account, allocations, reason, ticketID)
+	return refunded, stacktrace.Propagate(err, "")
+}
+
+// planRefund determines how Refund would split the amount among senders, without sending anything.
+// Senders are found by looking at what the account received since it last sent money, so refunds must be planned
+// before the account sends money elsewhere
+func (r *Refunder) planRefund(account *wallet.Account, amount *big.Int) ([]refundAllocation, error) {
+	if amount.Sign() <= 0 {
+		return []refundAllocation{}, nil
+	}
+
+	// we must receive pendings otherwise the history might not contain the latest tx
+	err := account.ReceivePendings()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to receive pendings in account %v", account.Address())
+	}
+
+	allocations, err := r.allocateToSenders(account, amount)
+	return allocations, stacktrace.Propagate(err, "")
+}
+
+// planContributorsRefund determines how RefundContributors would split the amount among contributors, without
+// sending anything. Like with planRefund, this must happen before the account sends money elsewhere
+func (r *Refunder) planContributorsRefund(account *wallet.Account, amount *big.Int) ([]refundAllocation, error) {
+	if amount.Sign() <= 0 {
+		return []refundAllocation{}, nil
+	}
+
+	// we must receive pendings otherwise the history might not contain the latest tx
+	err := account.ReceivePendings()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to receive pendings in account %v", account.Address())
+	}
+
+	contributions, err := findContributions(r.wallet, account)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(contributions) == 0 {
+		return nil, stacktrace.NewError("could not find who sent money to account %v", account.Address())
+	}
+
+	allocations := []refundAllocation{}
+	for _, share := range splitAmongContributors(amount, contributions) {
+		if share.Amount.Sign() > 0 {
+			allocations = append(allocations, refundAllocation{
+				recipient: share.Address,
+				amount:    share.Amount,
+			})
+		}
+	}
+	return allocations, nil
+}
+
+// sendRefunds sends the planned refunds. Returns how much was refunded and, if an error occurred halfway, the
+// allocations that are yet to be sent
+func (r *Refunder) sendRefunds(account *wallet.Account, allocations []refundAllocation, reason RefundReason, ticketID string) (*big.Int, []refundAllocation, error) {
+	refunded := big.NewInt(0)
+	for i, allocation := range allocations {
+		hash, err := account.Send(allocation.recipient, allocation.amount)
+		if err != nil {
+			return refunded, allocations[i:], stacktrace.Propagate(err, "failed to refund %v from account %v to %v",
+				allocation.amount, account.Address(), allocation.recipient)
+		}
+		refunded.Add(refunded, allocation.amount)
+		go r.statsClient.Count("refunds", 1)
+
+		entry := RefundLedgerEntry{
+			ID:             uuid.NewV4().String(),
+			RefundedAt:     time.Now(),
+			Reason:         reason,
+			TicketID:       ticketID,
+			PaymentAccount: account.Address(),
+			Recipient:      allocation.recipient,
+			Amount:         allocation.amount,
+			BlockHash:      hash.String(),
+		}
+		r.log.Printf("Refunded %v from account %v to %v (reason: %s, ticket: %s)",
+			entry.Amount, entry.PaymentAccount, entry.Recipient, entry.Reason, entry.TicketID)
+		err = r.recordInLedger(entry)
+		if err != nil {
+			// the money was already sent, so carry on with the remaining senders
+			r.log.Printf("error recording refund %s in ledger: %v", entry.ID, err)
+		}
+	}
+	return refunded, []refundAllocation{}, nil
+}
+
+type refundAllocation struct {
+	recipient string
+	amount    *big.Int
+}
+
+// allocateToSenders splits the amount among the senders of the payments received since the account last sent
+// money, starting with the most recent payment. Whatever can't be matched to a payment goes to the most recent sender
+func (r *Refunder) allocateToSenders(account *wallet.Account, amount *big.Int) ([]refundAllocation, error) {
+	history, _, err := r.wallet.RPC.AccountHistory(account.Address(), refundSenderHistoryLength, nil)
+	if err != nil {
+		if _, ok := err.(*json.UnmarshalTypeError); !ok {
+			return nil, stacktrace.Propagate(err, "failed to retrieve history for account %v", account.Address())
+		}
+		// account has no history. When this happens the node returns history: "" (which is not an empty array)
+		history = nil
+	}
+
+	allocations := []refundAllocation{}
+	remaining := new(big.Int).Set(amount)
+	for _, historyEntry := range history {
+		if historyEntry.Type == "send" {
+			// payments received before this were already dealt with
+			break
+		}
+		if historyEntry.Type != "receive" || historyEntry.Amount == nil || remaining.Sign() <= 0 {
+			continue
+		}
+		allocated := new(big.Int).Set(&historyEntry.Amount.Int)
+		if allocated.Cmp(remaining) > 0 {
+			allocated.Set(remaining)
+		}
+		remaining.Sub(remaining, allocated)
+		allocations = append(allocations, refundAllocation{
+			recipient: historyEntry.Account,
+			amount:    allocated,
+		})
+	}
+	if len(allocations) == 0 {
+		return nil, stacktrace.NewError("could not find who sent money to account %v", account.Address())
+	}
+	if remaining.Sign() > 0 {
+		allocations[0].amount.Add(allocations[0].amount, remaining)
+	}
+
+	// merge allocations to the same sender so that each refund needs as few blocks as possible
+	merged := []refundAllocation{}
+	indexByRecipient := make(map[string]int)
+	for _, allocation := range allocations {
+		if i, ok := indexByRecipient[allocation.recipient]; ok {
+			merged[i].amount.Add(merged[i].amount, allocation.amount)
+			continue
+		}
+		indexByRecipient[allocation.recipient] = len(merged)
+		merged = append(merged, allocation)
+	}
+	return merged, nil
+}
+
+func (r *Refunder) recordInLedger(entry RefundLedgerEntry) error {
+	if r.ledgerFile == "" {
+		return nil
+	}
+	marshalled, err := json.Marshal(entry)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing refund")
+	}
+
+	r.ledgerMutex.Lock()
+	defer r.ledgerMutex.Unlock()
+	f, err := os.OpenFile(r.ledgerFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error opening refund ledger")
+	}
+	defer f.Close()
+	_, err = f.Write(append(marshalled, '\n'))
+	return stacktrace.Propagate(err, "error writing to refund ledger")
+}
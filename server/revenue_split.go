@@ -0,0 +1,196 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/hectorchu/gonano/util"
+	"github.com/palantir/stacktrace"
+)
+
+// revenueSplitBasisPointsTotal is the number of basis points that corresponds to the whole request cost
+const revenueSplitBasisPointsTotal = 10000
+
+// RevenueSplit configures how the cost of each paid entry is divided once the entry finishes playing.
+// Shares are in basis points (hundredths of a percent) of the request cost. Whatever is not assigned to the treasury,
+// the donation address or the requester cashback is distributed among spectators
+type RevenueSplit struct {
+	TreasuryAddress              string
+	TreasuryBasisPoints          int64
+	DonationAddress              string
+	DonationBasisPoints          int64
+	RequesterCashbackBasisPoints int64
+}
+
+// Validate returns an error if the shares are out of range or if a share is assigned to a missing address
+func (s RevenueSplit) Validate() error {
+	shares := []struct {
+		name        string
+		basisPoints int64
+	}{
+		{"treasury", s.TreasuryBasisPoints},
+		{"donation", s.DonationBasisPoints},
+		{"requester cashback", s.RequesterCashbackBasisPoints},
+	}
+	total := int64(0)
+	for _, share := range shares {
+		if share.basisPoints < 0 || share.basisPoints > revenueSplitBasisPointsTotal {
+			return stacktrace.NewError("%s share must be between 0 and %d basis points",
+				share.name, revenueSplitBasisPointsTotal)
+		}
+		total += share.basisPoints
+	}
+	if total > revenueSplitBasisPointsTotal {
+		return stacktrace.NewError("shares add up to more than %d basis points", revenueSplitBasisPointsTotal)
+	}
+	if s.TreasuryBasisPoints > 0 && s.TreasuryAddress == "" {
+		return stacktrace.NewError("treasury share is set but the treasury address is missing")
+	}
+	if s.DonationBasisPoints > 0 && s.DonationAddress == "" {
+		return stacktrace.NewError("donation share is set but the donation address is missing")
+	}
+	for _, address := range []string{s.TreasuryAddress, s.DonationAddress} {
+		if address == "" {
+			continue
+		}
+		_, err := util.AddressToPubkey(address)
+		if err != nil || address[:4] != "ban_" { // we must check for ban since AddressToPubkey accepts nano too
+			return stacktrace.NewError("invalid address %s", address)
+		}
+	}
+	return nil
+}
+
+// RevenueShares is how the cost of an entry is divided
+type RevenueShares struct {
+	Spectators        Amount
+	Treasury          Amount
+	Donation          Amount
+	RequesterCashback Amount
+}
+
+// RevenueSplitter divides the cost of paid entries according to a RevenueSplit, and keeps track of the dust: the
+// amount left in the collector account because rewards are rounded down, which is added to the next distribution.
+// It is shared by all channels, as they share the collector account
+type RevenueSplitter struct {
+	log      *log.Logger
+	split    RevenueSplit
+	dustFile string
+
+	mu   sync.Mutex
+	dust *big.Int
+}
+
+type rewardDustFileContents struct {
+	Dust *big.Int
+}
+
+// NewRevenueSplitter returns a new RevenueSplitter. The dust is persisted in dustFile, if not empty
+func NewRevenueSplitter(log *log.Logger, split RevenueSplit, dustFile string) (*RevenueSplitter, error) {
+	err := split.Validate()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "invalid revenue split")
+	}
+	s := &RevenueSplitter{
+		log:      log,
+		split:    split,
+		dustFile: dustFile,
+		dust:     big.NewInt(0),
+	}
+	if dustFile == "" {
+		return s, nil
+	}
+
+	b, err := ioutil.ReadFile(dustFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, stacktrace.Propagate(err, "error reading reward dust from file")
+	}
+	var contents rewardDustFileContents
+	err = json.Unmarshal(b, &contents)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding reward dust from file %s", dustFile)
+	}
+	if contents.Dust != nil {
+		s.dust = contents.Dust
+	}
+	return s, nil
+}
+
+// Split divides the request cost according to the configured split
+func (s *RevenueSplitter) Split(requestCost Amount) RevenueShares {
+	share := func(basisPoints int64) Amount {
+		a := new(big.Int).Mul(requestCost.Int, big.NewInt(basisPoints))
+		return Amount{a.Div(a, big.NewInt(revenueSplitBasisPointsTotal))}
+	}
+	shares := RevenueShares{
+		Treasury:          share(s.split.TreasuryBasisPoints),
+		Donation:          share(s.split.DonationBasisPoints),
+		RequesterCashback: share(s.split.RequesterCashbackBasisPoints),
+	}
+	// spectators get whatever remains, so that the shares always add up to the request cost
+	spectators := new(big.Int).Sub(requestCost.Int, shares.Treasury.Int)
+	spectators.Sub(spectators, shares.Donation.Int)
+	spectators.Sub(spectators, shares.RequesterCashback.Int)
+	shares.Spectators = Amount{spectators}
+	return shares
+}
+
+// TreasuryAddress returns the address the treasury share is sent to
+func (s *RevenueSplitter) TreasuryAddress() string {
+	return s.split.TreasuryAddress
+}
+
+// DonationAddress returns the address the donation share is sent to
+func (s *RevenueSplitter) DonationAddress() string {
+	return s.split.DonationAddress
+}
+
+// TakeDust returns the current dust, to be included in a distribution, and resets it to zero
+func (s *RevenueSplitter) TakeDust() Amount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dust := s.dust
+	s.dust = big.NewInt(0)
+	if dust.Sign() != 0 {
+		s.persistNoMutex()
+	}
+	return Amount{dust}
+}
+
+// AddDust records an amount left in the collector account that was not distributed
+func (s *RevenueSplitter) AddDust(amount Amount) {
+	if amount.Sign() <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dust.Add(s.dust, amount.Int)
+	s.persistNoMutex()
+}
+
+func (s *RevenueSplitter) persistNoMutex() {
+	if s.dustFile == "" {
+		return
+	}
+	marshalled, err := json.Marshal(rewardDustFileContents{
+		Dust: s.dust,
+	})
+	if err == nil {
+		tmpFile := s.dustFile + ".tmp"
+		err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+		if err == nil {
+			err = os.Rename(tmpFile, s.dustFile)
+		}
+	}
+	if err != nil {
+		s.log.Printf("error persisting reward dust: %v", err)
+	}
+}
@@ -15,7 +15,11 @@ import (
 )
 
 func (s *grpcServer) SubmitActivityChallenge(ctx context.Context, r *proto.SubmitActivityChallengeRequest) (*proto.SubmitActivityChallengeResponse, error) {
-	return &proto.SubmitActivityChallengeResponse{}, s.rewardsHandler.SolveActivityChallenge(ctx, r.Challenge, r.CaptchaResponse, r.Trusted)
+	channel, err := s.channel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.SubmitActivityChallengeResponse{}, channel.rewardsHandler.SolveActivityChallenge(ctx, r.Challenge, r.CaptchaResponse, r.Trusted)
 }
 
 func spectatorActivityWatchdog(spectator *spectator, r *RewardsHandler) {
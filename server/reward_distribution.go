@@ -12,6 +12,7 @@ import (
 	"github.com/hectorchu/gonano/rpc"
 	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
 )
 
 func (r *RewardsHandler) rewardUsers(ctx context.Context, media MediaQueueEntry) error {
@@ -20,52 +21,99 @@ func (r *RewardsHandler) rewardUsers(ctx context.Context, media MediaQueueEntry)
 
 	r.log.Printf("Rewarding users for \"%s\"", media.MediaInfo().Title())
 
-	rewardBudget := media.RequestCost()
+	shares := r.revenueSplitter.Split(media.RequestCost())
+	rewardBudget := shares.Spectators
 
 	eligible := getEligibleSpectators(ctx, r.log, r.ipReputationChecker, r.moderationStore,
-		r.spectatorsByRemoteAddress, media.RequestedBy().Address(), media.PlayedFor())
+		r.spectatorsByRemoteAddress, entryPayerAddresses(media), media)
 	go r.statsClient.Gauge("eligible", len(eligible))
 
-	if rewardBudget.Cmp(big.NewInt(0)) == 0 {
+	rewardedSpectators := 0
+	amountForEach := Amount{big.NewInt(0)}
+	defer func() {
+		r.recordPlayedMedia(ctx, media, rewardedSpectators, amountForEach)
+	}()
+
+	if media.RequestCost().Cmp(big.NewInt(0)) == 0 {
 		r.log.Println("Request cost was 0, nothing to reward")
 		return nil
 	}
 
+	r.payRevenueShares(ctx, media, shares)
+
 	if len(eligible) == 0 {
-		if media.RequestedBy().IsUnknown() {
-			return nil
-		}
 		// reimburse who added to queue
-		go r.reimburseRequester(ctx, media.RequestedBy().Address(), rewardBudget)
+		r.reimbursePayers(ctx, media, rewardBudget)
+		r.recordRewardDistribution(ctx, media, RewardDistribution{
+			Budget:        rewardBudget.Int,
+			AmountForEach: amountForEach.Int,
+			Status:        RewardDistributionStatusReimbursed,
+		})
 		return nil
 	}
 
-	amountForEach := ComputeReward(rewardBudget, len(eligible))
+	// what was left over by the rounding of previous distributions is distributed along with this one
+	rewardBudget = Amount{new(big.Int).Add(rewardBudget.Int, r.revenueSplitter.TakeDust().Int)}
+	amountForEach = ComputeReward(rewardBudget, len(eligible))
+	distributed := Amount{new(big.Int).Mul(amountForEach.Int, big.NewInt(int64(len(eligible))))}
+	r.revenueSplitter.AddDust(Amount{new(big.Int).Sub(rewardBudget.Int, distributed.Int)})
 	go func() {
 		r.statsClient.Gauge("reward_per_spectator",
 			float64(new(big.Int).Div(amountForEach.Int, RewardRoundingFactor).Int64())/100.0)
 	}()
 	if amountForEach.Int.Cmp(big.NewInt(0)) <= 0 {
 		r.log.Printf("Not rewarding because the amount for each user would be zero")
+		r.recordRewardDistribution(ctx, media, RewardDistribution{
+			Budget:             rewardBudget.Int,
+			EligibleSpectators: len(eligible),
+			AmountForEach:      amountForEach.Int,
+			Status:             RewardDistributionStatusCarriedOver,
+		})
 		return nil
 	}
 
+	rewardedSpectators = len(eligible)
 	go func() {
 		t := r.statsClient.NewTiming()
-		r.rewardEligible(ctx, eligible, rewardBudget, amountForEach)
+		r.rewardEligible(ctx, media, eligible, rewardBudget, distributed, amountForEach)
 		t.Send("reward_distribution")
-		r.rewardsDistributed.Notify(rewardBudget, len(eligible))
+		r.rewardsDistributed.Notify(distributed, len(eligible))
 	}()
 	return nil
 }
 
+func (r *RewardsHandler) recordPlayedMedia(ctx context.Context, media MediaQueueEntry, rewardedSpectators int, amountForEach Amount) {
+	p, err := NewPlayedMedia(media, rewardedSpectators, amountForEach)
+	if err == nil {
+		err = r.playedMediaHistory.StorePlayedMedia(ctx, p)
+	}
+	if err != nil {
+		r.log.Printf("failed to record played media \"%s\" in history: %v", media.MediaInfo().Title(), err)
+	}
+}
+
+// recordRewardDistribution completes the record with the details of the entry and stores it in the reward ledger
+func (r *RewardsHandler) recordRewardDistribution(ctx context.Context, media MediaQueueEntry, d RewardDistribution) {
+	serialized, err := media.MarshalJSON()
+	if err == nil {
+		d.ID = uuid.NewV4().String()
+		d.ChannelID = r.channelID
+		d.Entry = serialized
+		d.DistributedAt = time.Now()
+		err = r.rewardLedger.StoreRewardDistribution(ctx, d)
+	}
+	if err != nil {
+		r.log.Printf("failed to record reward distribution for \"%s\" in ledger: %v", media.MediaInfo().Title(), err)
+	}
+}
+
 func getEligibleSpectators(ctx context.Context,
 	l *log.Logger,
 	c *IPAddressReputationChecker,
 	moderationStore ModerationStore,
 	spectatorsByRemoteAddress map[string][]*spectator,
-	exceptAddress string,
-	videoPlayedFor time.Duration) map[string]*spectator {
+	exceptAddresses []string,
+	media MediaQueueEntry) map[string]*spectator {
 	// maps addresses to spectators
 	toBeRewarded := make(map[string]*spectator)
 
@@ -87,7 +135,8 @@ func getEligibleSpectators(ctx context.Context,
 		spectatorsByUniquifiedRemoteAddress[uniquifiedIP] = append(spectatorsByUniquifiedRemoteAddress[uniquifiedIP], spectators...)
 	}
 
-	minAcceptableDuration := ((videoPlayedFor * 40) / 100)
+	// only consider the time during which the media was actually playing, i.e. not paused, in this server instance
+	minAcceptableDuration := ((media.PlaybackTimeSince(time.Time{}) * 40) / 100)
 
 	for k := range spectatorsByUniquifiedRemoteAddress {
 		spectators := spectatorsByUniquifiedRemoteAddress[k]
@@ -97,7 +146,7 @@ func getEligibleSpectators(ctx context.Context,
 		})
 		for j := range spectators {
 			// do not reward spectators who didn't watch at least 40% of the video
-			if time.Since(spectators[j].startedWatching) < minAcceptableDuration {
+			if media.PlaybackTimeSince(spectators[j].startedWatching) < minAcceptableDuration {
 				l.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress, "due to watching less than 40% of the last media")
 				continue
 			}
@@ -123,10 +172,28 @@ func getEligibleSpectators(ctx context.Context,
 			}
 		}
 	}
-	delete(toBeRewarded, exceptAddress)
+	for _, address := range exceptAddresses {
+		delete(toBeRewarded, address)
+	}
 	return toBeRewarded
 }
 
+// entryPayerAddresses returns the addresses of who paid for the entry, which are not rewarded for it.
+// Entries credited to someone else are still attributed to whoever paid for them
+func entryPayerAddresses(media MediaQueueEntry) []string {
+	if contributions := media.Contributions(); len(contributions) > 0 {
+		addresses := make([]string, len(contributions))
+		for i, contribution := range contributions {
+			addresses[i] = contribution.Address
+		}
+		return addresses
+	}
+	if media.RequestedBy().IsUnknown() {
+		return []string{}
+	}
+	return []string{media.RequestedBy().Address()}
+}
+
 func getUniquifiedIP(remoteAddress string) string {
 	ip := net.ParseIP(remoteAddress)
 	if ip == nil {
@@ -186,8 +253,9 @@ func (r *RewardsHandler) receiveCollectorPending(minExpectedBalance Amount) {
 	<-done
 }
 
-func (r *RewardsHandler) rewardEligible(ctx context.Context, eligible map[string]*spectator, requestCost Amount, amountForEach Amount) {
-	r.receiveCollectorPending(requestCost)
+func (r *RewardsHandler) rewardEligible(ctx context.Context, media MediaQueueEntry, eligible map[string]*spectator,
+	budget Amount, totalAmount Amount, amountForEach Amount) {
+	r.receiveCollectorPending(totalAmount)
 
 	r.collectorAccountQueue <- func(collectorAccount *wallet.Account, RPC rpc.Client, RPCWork rpc.Client) {
 		destinations := []wallet.SendDestination{}
@@ -200,18 +268,88 @@ func (r *RewardsHandler) rewardEligible(ctx context.Context, eligible map[string
 			})
 			spectators = append(spectators, spectator)
 		}
+		distribution := RewardDistribution{
+			Budget:             budget.Int,
+			EligibleSpectators: len(eligible),
+			AmountForEach:      amountForEach.Int,
+			Status:             RewardDistributionStatusDistributed,
+			Recipients:         make([]RewardRecipient, len(destinations)),
+		}
+		for i, destination := range destinations {
+			distribution.Recipients[i] = RewardRecipient{
+				Address: destination.Account,
+				Amount:  destination.Amount,
+			}
+		}
 		blockHashes, err := r.workGenerator.SendMultiple(RPC, RPCWork, collectorAccount, destinations)
 		if err != nil {
 			r.log.Printf("Error rewarding spectators: %v", err)
+			// the rewards remain in the collector account, so they go to the spectators of a future entry
+			r.revenueSplitter.AddDust(totalAmount)
+			distribution.Status = RewardDistributionStatusFailed
 		} else {
 			for i, hash := range blockHashes {
 				r.log.Printf("Rewarded %s with %v, block hash %s", spectators[i].user.Address(), amountForEach, hash.String())
 				spectators[i].onRewarded.Notify(amountForEach)
+				distribution.Recipients[i].BlockHash = hash.String()
 			}
 		}
+		r.recordRewardDistribution(ctx, media, distribution)
 	}
 }
 
+// payRevenueShares sends the shares of the request cost that don't go to spectators
+func (r *RewardsHandler) payRevenueShares(ctx context.Context, media MediaQueueEntry, shares RevenueShares) {
+	if shares.RequesterCashback.Sign() > 0 {
+		if len(entryPayerAddresses(media)) == 0 {
+			// there's nobody to send it to, so it goes to the spectators of a future entry
+			r.revenueSplitter.AddDust(shares.RequesterCashback)
+		} else {
+			r.reimbursePayers(ctx, media, shares.RequesterCashback)
+		}
+	}
+	if shares.Treasury.Sign() > 0 {
+		go r.sendRevenueShare(ctx, "treasury", r.revenueSplitter.TreasuryAddress(), shares.Treasury)
+	}
+	if shares.Donation.Sign() > 0 {
+		go r.sendRevenueShare(ctx, "donation", r.revenueSplitter.DonationAddress(), shares.Donation)
+	}
+}
+
+func (r *RewardsHandler) sendRevenueShare(ctx context.Context, shareName, address string, amount Amount) {
+	r.receiveCollectorPending(amount)
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.collectorAccountQueue <- func(collectorAccount *wallet.Account, _, _ rpc.Client) {
+		blockHash, err := collectorAccount.Send(address, amount.Int)
+		if err != nil {
+			r.log.Printf("Error sending %s share of %v to %s: %v", shareName, amount.Int, address, err)
+		} else {
+			r.log.Printf("Sent %s share of %v to %s, block hash %s", shareName, amount.Int, address, blockHash.String())
+		}
+	}
+}
+
+// reimbursePayers returns the amount to whoever paid for the entry: the contributors of crowdfunded entries, in
+// proportion to their contribution, or the requester of other entries
+func (r *RewardsHandler) reimbursePayers(ctx context.Context, media MediaQueueEntry, amount Amount) {
+	if contributions := media.Contributions(); len(contributions) > 0 {
+		for _, share := range splitAmongContributors(amount.Int, contributions) {
+			if share.Amount.Sign() > 0 {
+				go r.reimburseRequester(ctx, share.Address, Amount{share.Amount})
+			}
+		}
+		return
+	}
+	if media.RequestedBy().IsUnknown() {
+		return
+	}
+	go r.reimburseRequester(ctx, media.RequestedBy().Address(), amount)
+}
+
 func (r *RewardsHandler) reimburseRequester(ctx context.Context, address string, amount Amount) {
 	r.receiveCollectorPending(amount)
 
@@ -231,6 +369,12 @@ func (r *RewardsHandler) reimburseRequester(ctx context.Context, address string,
 
 func (r *RewardsHandler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
 	for accountIdx := uint32(1); ; accountIdx++ {
+		if r.paymentAccountPool.IsLeased(accountIdx) {
+			// the funds belong to a ticket that is still being handled, e.g. a crowdfunded ticket that may yet be
+			// refunded to its contributors
+			r.log.Printf("Account %d is held by a ticket, skipping", accountIdx)
+			continue
+		}
 		r.log.Printf("Attempting to find lost funds in account %d", accountIdx)
 		account, err := r.wallet.NewAccount(&accountIdx)
 		if err != nil {
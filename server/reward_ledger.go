@@ -0,0 +1,261 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RewardDistributionStatus is the outcome of the distribution of the rewards for a queue entry
+type RewardDistributionStatus string
+
+const (
+	// RewardDistributionStatusDistributed is used when the rewards were sent to the recipients
+	RewardDistributionStatusDistributed RewardDistributionStatus = "distributed"
+	// RewardDistributionStatusFailed is used when sending the rewards failed. The budget is carried over to the next
+	// distribution
+	RewardDistributionStatusFailed RewardDistributionStatus = "failed"
+	// RewardDistributionStatusReimbursed is used when there were no eligible spectators and the budget was returned
+	// to who paid for the entry
+	RewardDistributionStatusReimbursed RewardDistributionStatus = "reimbursed"
+	// RewardDistributionStatusCarriedOver is used when the budget was too small to reward each eligible spectator and
+	// was carried over to the next distribution
+	RewardDistributionStatusCarriedOver RewardDistributionStatus = "carried_over"
+)
+
+var rewardDistributionStatusesForAPI = map[RewardDistributionStatus]proto.RewardDistributionStatus{
+	RewardDistributionStatusDistributed: proto.RewardDistributionStatus_DISTRIBUTED,
+	RewardDistributionStatusFailed:      proto.RewardDistributionStatus_DISTRIBUTION_FAILED,
+	RewardDistributionStatusReimbursed:  proto.RewardDistributionStatus_REIMBURSED_TO_PAYERS,
+	RewardDistributionStatusCarriedOver: proto.RewardDistributionStatus_CARRIED_OVER,
+}
+
+// RewardDistribution is a record of the distribution of the rewards for a queue entry that finished playing
+type RewardDistribution struct {
+	ID        string
+	ChannelID string
+	// Entry is the queue entry, serialized in the same format used for persisting the queue
+	Entry              json.RawMessage
+	DistributedAt      time.Time
+	Budget             *big.Int
+	EligibleSpectators int
+	AmountForEach      *big.Int
+	Status             RewardDistributionStatus
+	Recipients         []RewardRecipient `json:",omitempty"`
+}
+
+// RewardRecipient is a spectator rewarded in a distribution. BlockHash is empty if sending the reward failed
+type RewardRecipient struct {
+	Address   string
+	Amount    *big.Int
+	BlockHash string `json:",omitempty"`
+}
+
+// SerializeForAPI serializes the record for the API
+func (d RewardDistribution) SerializeForAPI() (*proto.RewardDistribution, error) {
+	entry, err := decodeQueueEntry(d.Entry)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	serialized := &proto.RewardDistribution{
+		Id:                 d.ID,
+		ChannelId:          d.ChannelID,
+		Entry:              entry.SerializeForAPI(),
+		DistributedAt:      timestamppb.New(d.DistributedAt),
+		Budget:             Amount{d.Budget}.SerializeForAPI(),
+		EligibleSpectators: uint32(d.EligibleSpectators),
+		AmountForEach:      Amount{d.AmountForEach}.SerializeForAPI(),
+		Status:             rewardDistributionStatusesForAPI[d.Status],
+		Recipients:         make([]*proto.RewardRecipient, len(d.Recipients)),
+	}
+	for i, recipient := range d.Recipients {
+		serialized.Recipients[i] = &proto.RewardRecipient{
+			Address:   recipient.Address,
+			Amount:    Amount{recipient.Amount}.SerializeForAPI(),
+			BlockHash: recipient.BlockHash,
+		}
+	}
+	return serialized, nil
+}
+
+// SerializeForRecipientForAPI serializes the part of the record that concerns the recipient with the given address
+func (d RewardDistribution) SerializeForRecipientForAPI(address string) (*proto.ReceivedReward, error) {
+	entry, err := decodeQueueEntry(d.Entry)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	serialized := &proto.ReceivedReward{
+		DistributionId: d.ID,
+		ChannelId:      d.ChannelID,
+		Entry:          entry.SerializeForAPI(),
+		DistributedAt:  timestamppb.New(d.DistributedAt),
+		Amount:         "0",
+		Status:         rewardDistributionStatusesForAPI[d.Status],
+	}
+	for _, recipient := range d.Recipients {
+		if recipient.Address == address {
+			serialized.Amount = Amount{recipient.Amount}.SerializeForAPI()
+			serialized.BlockHash = recipient.BlockHash
+			break
+		}
+	}
+	return serialized, nil
+}
+
+// RewardLedgerStore saves and loads the records of reward distributions
+type RewardLedgerStore interface {
+	StoreRewardDistribution(context.Context, RewardDistribution) error
+	// LoadRewardDistributions returns distributions ordered from the most to the least recent, along with the total
+	// number of matching records. If address is not empty, only distributions in which it was a recipient are returned
+	LoadRewardDistributions(ctx context.Context, address string, offset, limit int) ([]RewardDistribution, int, error)
+	// LoadTotalRewarded returns the sum of the rewards successfully sent to the address
+	LoadTotalRewarded(ctx context.Context, address string) (Amount, error)
+}
+
+// RewardLedgerStoreMemory keeps the reward ledger in memory, optionally appending each record to a file from which
+// the ledger is restored on startup
+type RewardLedgerStoreMemory struct {
+	l      sync.RWMutex
+	ledger []RewardDistribution
+	// byRecipient maps an address to the indexes of the distributions in which it was a recipient
+	byRecipient map[string][]int
+	// totalRewarded maps an address to the sum of the rewards successfully sent to it
+	totalRewarded map[string]*big.Int
+
+	persistenceFile string
+}
+
+var _ RewardLedgerStore = &RewardLedgerStoreMemory{}
+
+// NewRewardLedgerStoreMemory returns a new RewardLedgerStoreMemory
+func NewRewardLedgerStoreMemory(persistenceFile string) (*RewardLedgerStoreMemory, error) {
+	s := &RewardLedgerStoreMemory{
+		byRecipient:     make(map[string][]int),
+		totalRewarded:   make(map[string]*big.Int),
+		persistenceFile: persistenceFile,
+	}
+	if persistenceFile != "" {
+		err := s.restoreFromFile(persistenceFile)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return s, nil
+}
+
+func (s *RewardLedgerStoreMemory) StoreRewardDistribution(ctx context.Context, d RewardDistribution) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if s.persistenceFile != "" {
+		err := s.appendToFile(s.persistenceFile, d)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	s.addInMutex(d)
+	return nil
+}
+
+func (s *RewardLedgerStoreMemory) LoadRewardDistributions(ctx context.Context, address string, offset, limit int) ([]RewardDistribution, int, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	result := []RewardDistribution{}
+	if address == "" {
+		for i := len(s.ledger) - 1 - offset; i >= 0 && len(result) < limit; i-- {
+			result = append(result, s.ledger[i])
+		}
+		return result, len(s.ledger), nil
+	}
+
+	indexes := s.byRecipient[address]
+	for i := len(indexes) - 1 - offset; i >= 0 && len(result) < limit; i-- {
+		result = append(result, s.ledger[indexes[i]])
+	}
+	return result, len(indexes), nil
+}
+
+func (s *RewardLedgerStoreMemory) LoadTotalRewarded(ctx context.Context, address string) (Amount, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	total := big.NewInt(0)
+	if rewarded, present := s.totalRewarded[address]; present {
+		total.Set(rewarded)
+	}
+	return Amount{total}, nil
+}
+
+func (s *RewardLedgerStoreMemory) addInMutex(d RewardDistribution) {
+	s.ledger = append(s.ledger, d)
+	for _, recipient := range d.Recipients {
+		s.byRecipient[recipient.Address] = append(s.byRecipient[recipient.Address], len(s.ledger)-1)
+		if d.Status != RewardDistributionStatusDistributed || recipient.Amount == nil {
+			continue
+		}
+		total, present := s.totalRewarded[recipient.Address]
+		if !present {
+			total = big.NewInt(0)
+			s.totalRewarded[recipient.Address] = total
+		}
+		total.Add(total, recipient.Amount)
+	}
+}
+
+// appendToFile appends the record to the file as one line of JSON, so that the whole ledger doesn't need to be
+// rewritten every time rewards are distributed
+func (s *RewardLedgerStoreMemory) appendToFile(file string, d RewardDistribution) error {
+	marshalled, err := json.Marshal(d)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return stacktrace.Propagate(err, "error opening reward ledger file")
+	}
+	defer f.Close()
+	_, err = f.Write(append(marshalled, '\n'))
+	return stacktrace.Propagate(err, "error writing to reward ledger file")
+}
+
+func (s *RewardLedgerStoreMemory) restoreFromFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "error reading reward ledger from file: %v", err)
+	}
+	defer f.Close()
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	scanner := bufio.NewScanner(f)
+	// records list every recipient, so they can get much longer than those of the played media history
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var d RewardDistribution
+		err = json.Unmarshal(scanner.Bytes(), &d)
+		if err != nil {
+			return stacktrace.Propagate(err, "error decoding line %d of reward ledger file", line)
+		}
+		s.addInMutex(d)
+	}
+	return stacktrace.Propagate(scanner.Err(), "error reading reward ledger from file")
+}
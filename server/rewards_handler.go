@@ -27,10 +27,15 @@ type RewardsHandler struct {
 	collectorAccountQueue          chan func(*wallet.Account, rpc.Client, rpc.Client)
 	workGenerator                  *WorkGenerator
 	paymentAccountPendingWaitGroup *sync.WaitGroup
+	paymentAccountPool             *PaymentAccountPool
 	lastMedia                      MediaQueueEntry
 	hCaptchaSecret                 string
 	hCaptchaHTTPClient             http.Client
 	moderationStore                ModerationStore
+	playedMediaHistory             PlayedMediaHistoryStore
+	revenueSplitter                *RevenueSplitter
+	rewardLedger                   RewardLedgerStore
+	channelID                      string
 
 	rewardsDistributed *event.Event
 
@@ -100,7 +105,12 @@ func NewRewardsHandler(log *log.Logger,
 	collectorAccountQueue chan func(*wallet.Account, rpc.Client, rpc.Client),
 	workGenerator *WorkGenerator,
 	paymentAccountPendingWaitGroup *sync.WaitGroup,
-	moderationStore ModerationStore) (*RewardsHandler, error) {
+	paymentAccountPool *PaymentAccountPool,
+	moderationStore ModerationStore,
+	playedMediaHistory PlayedMediaHistoryStore,
+	revenueSplitter *RevenueSplitter,
+	rewardLedger RewardLedgerStore,
+	channelID string) (*RewardsHandler, error) {
 	return &RewardsHandler{
 		log:                            log,
 		statsClient:                    statsClient,
@@ -110,11 +120,16 @@ func NewRewardsHandler(log *log.Logger,
 		collectorAccountQueue:          collectorAccountQueue,
 		workGenerator:                  workGenerator,
 		paymentAccountPendingWaitGroup: paymentAccountPendingWaitGroup,
+		paymentAccountPool:             paymentAccountPool,
 		hCaptchaSecret:                 hCaptchaSecret,
 		hCaptchaHTTPClient: http.Client{
 			Timeout: 10 * time.Second,
 		},
-		moderationStore: moderationStore,
+		moderationStore:    moderationStore,
+		playedMediaHistory: playedMediaHistory,
+		revenueSplitter:    revenueSplitter,
+		rewardLedger:       rewardLedger,
+		channelID:          channelID,
 
 		rewardsDistributed: event.New(),
 
@@ -289,11 +304,8 @@ func (r *RewardsHandler) onMediaRemoved(ctx context.Context, removed MediaQueueE
 		r.log.Println("Request cost was 0, nothing to reimburse")
 		return nil
 	}
-	if removed.RequestedBy().IsUnknown() {
-		return nil
-	}
 	// reimburse who added to queue
-	go r.reimburseRequester(ctx, removed.RequestedBy().Address(), removed.RequestCost())
+	r.reimbursePayers(ctx, removed, removed.RequestCost())
 	return nil
 }
 
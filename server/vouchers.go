@@ -0,0 +1,453 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// VoucherType is the kind of benefit given by a voucher
+type VoucherType string
+
+const (
+	// VoucherTypePercentageDiscount vouchers reduce the prices of a ticket by a percentage
+	VoucherTypePercentageDiscount VoucherType = "percentage_discount"
+	// VoucherTypeFixedDiscount vouchers reduce the prices of a ticket by a fixed amount
+	VoucherTypeFixedDiscount VoucherType = "fixed_discount"
+	// VoucherTypeFreeEnqueue vouchers enqueue media up to a certain length without payment
+	VoucherTypeFreeEnqueue VoucherType = "free_enqueue"
+)
+
+// vouchersFileFormatVersion is the version of the format written by persistNoMutex
+const vouchersFileFormatVersion = 1
+
+// generatedVoucherCodeLength is the length of the codes generated for vouchers minted without a code
+const generatedVoucherCodeLength = 10
+
+// ErrVoucherNotFound is returned when there is no voucher with the specified code
+var ErrVoucherNotFound = errors.New("voucher not found")
+
+// ErrVoucherNotRedeemable is returned when a voucher was revoked, expired or reached its redemption limits
+var ErrVoucherNotRedeemable = errors.New("voucher can no longer be redeemed")
+
+// ErrVoucherRedemptionLimitReached is returned when a user already redeemed a voucher as many times as allowed
+var ErrVoucherRedemptionLimitReached = errors.New("voucher was already redeemed by this user")
+
+// ErrVoucherNotApplicable is returned when a voucher can't be applied to the media being enqueued
+var ErrVoucherNotApplicable = errors.New("voucher is not applicable to this media")
+
+// Voucher is a promotional code that gives a discount on, or waives the payment of, enqueue tickets
+type Voucher struct {
+	Code                 string
+	Type                 VoucherType
+	PercentageDiscount   int64    `json:",omitempty"`
+	FixedDiscount        *big.Int `json:",omitempty"`
+	FreeEnqueueMaxLength Duration `json:",omitempty"`
+	// MaxRedemptions and MaxRedemptionsPerUser are unlimited when zero
+	MaxRedemptions        int
+	MaxRedemptionsPerUser int
+	// ExpiresAt is nil for vouchers that are valid until revoked
+	ExpiresAt *time.Time `json:",omitempty"`
+	CreatedAt time.Time
+	CreatedBy string
+	RevokedAt *time.Time `json:",omitempty"`
+}
+
+// VoucherTypesForAPI maps voucher types to their API representation
+var VoucherTypesForAPI = map[VoucherType]proto.VoucherType{
+	VoucherTypePercentageDiscount: proto.VoucherType_PERCENTAGE_DISCOUNT,
+	VoucherTypeFixedDiscount:      proto.VoucherType_FIXED_DISCOUNT,
+	VoucherTypeFreeEnqueue:        proto.VoucherType_FREE_ENQUEUE,
+}
+
+// VoucherRedemption records the use of a voucher
+type VoucherRedemption struct {
+	ID         string
+	Code       string
+	RedeemedAt time.Time
+	RedeemedBy string
+	// TicketID is empty while the ticket is being registered
+	TicketID string
+}
+
+// Validate returns an error if the voucher doesn't give a valid benefit or if its limits are invalid
+func (v *Voucher) Validate() error {
+	switch v.Type {
+	case VoucherTypePercentageDiscount:
+		if v.PercentageDiscount <= 0 || v.PercentageDiscount >= 100 {
+			return stacktrace.NewError("percentage discount must be between 1 and 99, use a free enqueue voucher instead")
+		}
+	case VoucherTypeFixedDiscount:
+		if v.FixedDiscount == nil || v.FixedDiscount.Sign() <= 0 {
+			return stacktrace.NewError("fixed discount must be positive")
+		}
+	case VoucherTypeFreeEnqueue:
+		if v.FreeEnqueueMaxLength <= 0 {
+			return stacktrace.NewError("maximum length of free enqueues must be positive")
+		}
+	default:
+		return stacktrace.NewError("unknown voucher type %s", v.Type)
+	}
+	if v.MaxRedemptions < 0 || v.MaxRedemptionsPerUser < 0 {
+		return stacktrace.NewError("redemption limits must not be negative")
+	}
+	return nil
+}
+
+// SerializeForAPI serializes the voucher along with how many times it was redeemed
+func (v *Voucher) SerializeForAPI(redemptionCount int) *proto.Voucher {
+	serialized := &proto.Voucher{
+		Code:                  v.Code,
+		Type:                  VoucherTypesForAPI[v.Type],
+		PercentageDiscount:    uint32(v.PercentageDiscount),
+		MaxRedemptions:        uint32(v.MaxRedemptions),
+		MaxRedemptionsPerUser: uint32(v.MaxRedemptionsPerUser),
+		CreatedAt:             timestamppb.New(v.CreatedAt),
+		CreatedBy:             v.CreatedBy,
+		RedemptionCount:       uint32(redemptionCount),
+		Revoked:               v.RevokedAt != nil,
+	}
+	if v.FixedDiscount != nil {
+		serialized.FixedDiscount = v.FixedDiscount.String()
+	}
+	if v.FreeEnqueueMaxLength > 0 {
+		serialized.FreeEnqueueMaxLength = durationpb.New(time.Duration(v.FreeEnqueueMaxLength))
+	}
+	if v.ExpiresAt != nil {
+		serialized.Expiration = timestamppb.New(*v.ExpiresAt)
+	}
+	return serialized
+}
+
+// IsFreeEnqueue returns whether the voucher waives the payment of the ticket, rather than discounting its prices
+func (v *Voucher) IsFreeEnqueue() bool {
+	return v.Type == VoucherTypeFreeEnqueue
+}
+
+// ApplyToPricing returns the prices discounted by the voucher. Discounted prices never go below
+// PriceRoundingFactor, as a price of zero would be met before any payment is made
+func (v *Voucher) ApplyToPricing(pricing EnqueuePricing) EnqueuePricing {
+	discount := func(price Amount) Amount {
+		discounted := new(big.Int).Set(price.Int)
+		switch v.Type {
+		case VoucherTypePercentageDiscount:
+			discounted.Mul(discounted, big.NewInt(100-v.PercentageDiscount))
+			discounted.Div(discounted, big.NewInt(100))
+		case VoucherTypeFixedDiscount:
+			discounted.Sub(discounted, v.FixedDiscount)
+		default:
+			return price
+		}
+		if discounted.Cmp(PriceRoundingFactor) < 0 {
+			discounted.Set(PriceRoundingFactor)
+		}
+		return Amount{discounted}
+	}
+	return EnqueuePricing{
+		EnqueuePrice:  discount(pricing.EnqueuePrice),
+		PlayNextPrice: discount(pricing.PlayNextPrice),
+		PlayNowPrice:  discount(pricing.PlayNowPrice),
+	}
+}
+
+// VoucherManager keeps the vouchers and their redemptions
+type VoucherManager struct {
+	log  *log.Logger
+	file string
+
+	mu          sync.RWMutex
+	vouchers    map[string]*Voucher
+	redemptions []VoucherRedemption
+}
+
+type vouchersFileContents struct {
+	Version     int
+	Vouchers    []*Voucher
+	Redemptions []VoucherRedemption
+}
+
+// NewVoucherManager returns a new VoucherManager. Vouchers and redemptions are persisted in file, if not empty
+func NewVoucherManager(log *log.Logger, file string) (*VoucherManager, error) {
+	m := &VoucherManager{
+		log:         log,
+		file:        file,
+		vouchers:    make(map[string]*Voucher),
+		redemptions: []VoucherRedemption{},
+	}
+	if file == "" {
+		return m, nil
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return m, nil
+		}
+		return nil, stacktrace.Propagate(err, "error reading vouchers from file")
+	}
+	var contents vouchersFileContents
+	err = json.Unmarshal(b, &contents)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error decoding vouchers from file %s", file)
+	}
+	if contents.Version > vouchersFileFormatVersion {
+		return nil, stacktrace.NewError("vouchers file format version %d is newer than the supported version %d",
+			contents.Version, vouchersFileFormatVersion)
+	}
+	for _, voucher := range contents.Vouchers {
+		m.vouchers[voucher.Code] = voucher
+	}
+	if contents.Redemptions != nil {
+		m.redemptions = contents.Redemptions
+	}
+	return m, nil
+}
+
+// NormalizeVoucherCode returns the code in the form vouchers are stored in, as codes are not case sensitive
+func NormalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Mint adds a new voucher, generating a random code for it if it has none
+func (m *VoucherManager) Mint(voucher Voucher) (Voucher, error) {
+	err := voucher.Validate()
+	if err != nil {
+		return Voucher{}, stacktrace.Propagate(err, "")
+	}
+	voucher.Code = NormalizeVoucherCode(voucher.Code)
+	if voucher.Code == "" {
+		voucher.Code, err = generateVoucherCode()
+		if err != nil {
+			return Voucher{}, stacktrace.Propagate(err, "")
+		}
+	}
+	voucher.CreatedAt = time.Now()
+	voucher.RevokedAt = nil
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, present := m.vouchers[voucher.Code]; present {
+		return Voucher{}, stacktrace.NewError("a voucher with code %s already exists", voucher.Code)
+	}
+	m.vouchers[voucher.Code] = &voucher
+	m.persistNoMutex()
+	return voucher, nil
+}
+
+func generateVoucherCode() (string, error) {
+	b := make([]byte, generatedVoucherCodeLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "error generating voucher code")
+	}
+	return base32.StdEncoding.EncodeToString(b)[:generatedVoucherCodeLength], nil
+}
+
+// Vouchers returns the vouchers along with how many times each one was redeemed, sorted from most to least recently
+// created. Inactive vouchers are those that were revoked, expired or fully redeemed
+func (m *VoucherManager) Vouchers(includeInactive bool) ([]Voucher, []int) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	vouchers := []Voucher{}
+	for _, voucher := range m.vouchers {
+		if includeInactive || m.redeemableNoMutex(voucher, now) {
+			vouchers = append(vouchers, *voucher)
+		}
+	}
+	sort.Slice(vouchers, func(i, j int) bool {
+		return vouchers[i].CreatedAt.After(vouchers[j].CreatedAt)
+	})
+	counts := make([]int, len(vouchers))
+	for i := range vouchers {
+		counts[i], _ = m.redemptionCountNoMutex(vouchers[i].Code, "")
+	}
+	return vouchers, counts
+}
+
+// Revoke prevents any further redemptions of the voucher
+func (m *VoucherManager) Revoke(code string) (Voucher, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	voucher, present := m.vouchers[NormalizeVoucherCode(code)]
+	if !present {
+		return Voucher{}, stacktrace.Propagate(ErrVoucherNotFound, "")
+	}
+	if voucher.RevokedAt == nil {
+		now := time.Now()
+		voucher.RevokedAt = &now
+		m.persistNoMutex()
+	}
+	return *voucher, nil
+}
+
+// Check returns the voucher if the user could redeem it to enqueue media with the given length, without redeeming it
+func (m *VoucherManager) Check(code string, user User, mediaLength time.Duration) (Voucher, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	voucher, err := m.checkNoMutex(NormalizeVoucherCode(code), user, mediaLength)
+	if err != nil {
+		return Voucher{}, stacktrace.Propagate(err, "")
+	}
+	return *voucher, nil
+}
+
+// Redeem records the redemption of the voucher by the user to enqueue media with the given length. The returned
+// redemption ID must be passed to AssociateRedemptionWithTicket once the ticket is registered, or to CancelRedemption
+// if that fails
+func (m *VoucherManager) Redeem(code string, user User, mediaLength time.Duration) (Voucher, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	voucher, err := m.checkNoMutex(NormalizeVoucherCode(code), user, mediaLength)
+	if err != nil {
+		return Voucher{}, "", stacktrace.Propagate(err, "")
+	}
+	redemption := VoucherRedemption{
+		ID:         uuid.NewV4().String(),
+		Code:       voucher.Code,
+		RedeemedAt: time.Now(),
+		RedeemedBy: user.Address(),
+	}
+	m.redemptions = append(m.redemptions, redemption)
+	m.persistNoMutex()
+	return *voucher, redemption.ID, nil
+}
+
+// AssociateRedemptionWithTicket records the ticket that resulted from a redemption
+func (m *VoucherManager) AssociateRedemptionWithTicket(redemptionID, ticketID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.redemptions {
+		if m.redemptions[i].ID == redemptionID {
+			m.redemptions[i].TicketID = ticketID
+			m.persistNoMutex()
+			return
+		}
+	}
+}
+
+// CancelRedemption removes a redemption whose ticket could not be registered, so that it doesn't count towards the
+// redemption limits
+func (m *VoucherManager) CancelRedemption(redemptionID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.redemptions {
+		if m.redemptions[i].ID == redemptionID {
+			m.redemptions = append(m.redemptions[:i], m.redemptions[i+1:]...)
+			m.persistNoMutex()
+			return
+		}
+	}
+}
+
+// Redemptions returns the redemptions of the voucher, from most to least recent, along with the total number of
+// redemptions of the voucher
+func (m *VoucherManager) Redemptions(code string, offset, limit int) ([]VoucherRedemption, int) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	code = NormalizeVoucherCode(code)
+	matching := []VoucherRedemption{}
+	for i := len(m.redemptions) - 1; i >= 0; i-- {
+		if m.redemptions[i].Code == code {
+			matching = append(matching, m.redemptions[i])
+		}
+	}
+	total := len(matching)
+	if offset >= total {
+		return []VoucherRedemption{}, total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return matching[offset:end], total
+}
+
+func (m *VoucherManager) checkNoMutex(code string, user User, mediaLength time.Duration) (*Voucher, error) {
+	voucher, present := m.vouchers[code]
+	if !present {
+		return nil, stacktrace.Propagate(ErrVoucherNotFound, "")
+	}
+	if !m.redeemableNoMutex(voucher, time.Now()) {
+		return nil, stacktrace.Propagate(ErrVoucherNotRedeemable, "")
+	}
+	if voucher.MaxRedemptionsPerUser > 0 {
+		_, byUser := m.redemptionCountNoMutex(code, user.Address())
+		if byUser >= voucher.MaxRedemptionsPerUser {
+			return nil, stacktrace.Propagate(ErrVoucherRedemptionLimitReached, "")
+		}
+	}
+	if voucher.IsFreeEnqueue() && mediaLength > time.Duration(voucher.FreeEnqueueMaxLength) {
+		return nil, stacktrace.Propagate(ErrVoucherNotApplicable, "")
+	}
+	return voucher, nil
+}
+
+func (m *VoucherManager) redeemableNoMutex(voucher *Voucher, now time.Time) bool {
+	if voucher.RevokedAt != nil || (voucher.ExpiresAt != nil && now.After(*voucher.ExpiresAt)) {
+		return false
+	}
+	if voucher.MaxRedemptions > 0 {
+		count, _ := m.redemptionCountNoMutex(voucher.Code, "")
+		return count < voucher.MaxRedemptions
+	}
+	return true
+}
+
+// redemptionCountNoMutex returns how many times the voucher was redeemed in total and by the specified address
+func (m *VoucherManager) redemptionCountNoMutex(code, address string) (int, int) {
+	total, byAddress := 0, 0
+	for _, redemption := range m.redemptions {
+		if redemption.Code != code {
+			continue
+		}
+		total++
+		if address != "" && redemption.RedeemedBy == address {
+			byAddress++
+		}
+	}
+	return total, byAddress
+}
+
+func (m *VoucherManager) persistNoMutex() {
+	if m.file == "" {
+		return
+	}
+	vouchers := make([]*Voucher, 0, len(m.vouchers))
+	for _, voucher := range m.vouchers {
+		vouchers = append(vouchers, voucher)
+	}
+	sort.Slice(vouchers, func(i, j int) bool {
+		return vouchers[i].CreatedAt.Before(vouchers[j].CreatedAt)
+	})
+	marshalled, err := json.Marshal(vouchersFileContents{
+		Version:     vouchersFileFormatVersion,
+		Vouchers:    vouchers,
+		Redemptions: m.redemptions,
+	})
+	if err == nil {
+		tmpFile := m.file + ".tmp"
+		err = ioutil.WriteFile(tmpFile, marshalled, 0644)
+		if err == nil {
+			err = os.Rename(tmpFile, m.file)
+		}
+	}
+	if err != nil {
+		m.log.Printf("error persisting vouchers: %v", err)
+	}
+}